@@ -0,0 +1,150 @@
+// Package bench measures time and memory solvers take on real inputs, and compares saved measurements to catch
+// regressions.
+package bench
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"runtime/metrics"
+	"sync"
+	"time"
+
+	"github.com/harmlessevil/advent-of-code-2023/puzzles"
+)
+
+// Measurement of a part of a day. Times are the best of several runs, memory is measured in a separate run.
+type Measurement struct {
+	Day  int    `json:"day"`
+	Name string `json:"name"`
+	Part int    `json:"part"`
+
+	Parse      time.Duration `json:"parse_ns"` // zero for days streaming the input while solving
+	Solve      time.Duration `json:"solve_ns"` // without parsing, if it's measured apart
+	Allocs     uint64        `json:"allocs"`
+	AllocBytes uint64        `json:"alloc_bytes"`
+	PeakBytes  uint64        `json:"peak_bytes"` // of the heap above what was in use before the run
+}
+
+type Report struct {
+	GoVersion    string        `json:"go_version"`
+	Time         time.Time     `json:"time"`
+	Measurements []Measurement `json:"measurements"`
+}
+
+func NewReport(measurements []Measurement) Report {
+	return Report{
+		GoVersion:    runtime.Version(),
+		Time:         time.Now(),
+		Measurements: measurements,
+	}
+}
+
+// Measure runs the part of the puzzle on the input.
+func Measure(puzzle puzzles.Puzzle, part int, input []byte, runs int) (Measurement, error) {
+	solver, err := puzzle.Part(part)
+	if err != nil {
+		return Measurement{}, err
+	}
+
+	m := Measurement{
+		Day:  puzzle.Day,
+		Name: puzzle.Name,
+		Part: part,
+	}
+
+	if m.Allocs, m.AllocBytes, m.PeakBytes, err = measureMemory(solver, input); err != nil {
+		return Measurement{}, fmt.Errorf("solve day %d part %d: %w", puzzle.Day, part, err)
+	}
+
+	var total time.Duration
+	for i := 0; i < runs; i++ {
+		// Parts streaming the input overlap parsing with solving, so subtracting the time parsing takes alone means
+		// nothing for them.
+		if puzzle.Parse != nil && !puzzle.Streaming {
+			start := time.Now()
+			if err := puzzle.Parse(bytes.NewReader(input)); err != nil {
+				return Measurement{}, fmt.Errorf("parse day %d: %w", puzzle.Day, err)
+			}
+
+			m.Parse = best(m.Parse, time.Since(start))
+		}
+
+		start := time.Now()
+		if _, err := solver.Solve(bytes.NewReader(input)); err != nil {
+			return Measurement{}, fmt.Errorf("solve day %d part %d: %w", puzzle.Day, part, err)
+		}
+
+		total = best(total, time.Since(start))
+	}
+
+	m.Solve = max(total-m.Parse, 0)
+
+	return m, nil
+}
+
+// best returns the shortest duration, treating zero as not measured yet.
+func best(current, next time.Duration) time.Duration {
+	if current == 0 {
+		return next
+	}
+
+	return min(current, next)
+}
+
+// heapMetric counts bytes of heap objects, including unreachable ones not collected yet.
+const heapMetric = "/memory/classes/heap/objects:bytes"
+
+// peakInterval is how often the heap is sampled to find its peak.
+const peakInterval = 100 * time.Microsecond
+
+// measureMemory runs the solver once, counting allocations and sampling the heap.
+func measureMemory(solver puzzles.Solver, input []byte) (allocs, allocBytes, peakBytes uint64, err error) {
+	runtime.GC()
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+
+	samples := []metrics.Sample{{Name: heapMetric}}
+	readHeap := func() uint64 {
+		metrics.Read(samples)
+		return samples[0].Value.Uint64()
+	}
+
+	baseline := readHeap()
+	peak := baseline
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(peakInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				peak = max(peak, readHeap())
+			}
+		}
+	}()
+
+	_, err = solver.Solve(bytes.NewReader(input))
+
+	close(done)
+	wg.Wait()
+
+	peak = max(peak, readHeap())
+	runtime.ReadMemStats(&after)
+
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return after.Mallocs - before.Mallocs, after.TotalAlloc - before.TotalAlloc, peak - baseline, nil
+}
@@ -0,0 +1,117 @@
+package bench
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/harmlessevil/advent-of-code-2023/puzzles"
+)
+
+func TestMeasure(t *testing.T) {
+	puzzle, err := puzzles.Lookup(6)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := []byte("Time:      7  15   30\nDistance:  9  40  200\n")
+
+	m, err := Measure(puzzle, 1, input, 3)
+	if err != nil {
+		t.Fatalf("measure: %v", err)
+	}
+
+	if m.Day != 6 || m.Part != 1 || m.Name != puzzle.Name {
+		t.Errorf("got measurement of day %d part %d (%s)", m.Day, m.Part, m.Name)
+	}
+
+	if m.Parse <= 0 || m.Allocs == 0 || m.AllocBytes == 0 {
+		t.Errorf("got measurement %+v without parse time or allocations", m)
+	}
+
+	if _, err := Measure(puzzle, 1, []byte("garbage"), 1); err == nil {
+		t.Errorf("got no error for malformed input")
+	}
+}
+
+func TestMeasureStreaming(t *testing.T) {
+	puzzle, err := puzzles.Lookup(9)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := Measure(puzzle, 1, []byte("0 3 6 9 12 15\n1 3 6 10 15 21\n"), 3)
+	if err != nil {
+		t.Fatalf("measure: %v", err)
+	}
+
+	if m.Parse != 0 || m.Solve <= 0 {
+		t.Errorf("got measurement %+v, want parsing counted in solving", m)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	old := Report{Measurements: []Measurement{
+		{Day: 1, Part: 1, Solve: time.Millisecond, Allocs: 100, PeakBytes: 1000},
+		{Day: 1, Part: 2, Solve: time.Millisecond, Allocs: 100, PeakBytes: 1000},
+		{Day: 2, Part: 1, Solve: time.Millisecond},
+	}}
+	current := Report{Measurements: []Measurement{
+		{Day: 1, Part: 1, Solve: 1050 * time.Microsecond, Allocs: 90, PeakBytes: 1000},
+		{Day: 1, Part: 2, Solve: time.Millisecond, Allocs: 100, PeakBytes: 2000},
+		{Day: 3, Part: 1, Solve: time.Millisecond},
+	}}
+
+	changes := Compare(old, current)
+	if len(changes) != 2 {
+		t.Fatalf("got %d changes, want 2 for parts in both reports", len(changes))
+	}
+
+	if changes[0].Regressed(0.1) {
+		t.Errorf("got a regression for %+v within the threshold", changes[0])
+	}
+
+	if !changes[1].Regressed(0.1) {
+		t.Errorf("got no regression for %+v with twice the peak memory", changes[1])
+	}
+
+	var out bytes.Buffer
+
+	regressions, err := WriteComparison(&out, changes, 0.1)
+	if err != nil || regressions != 1 {
+		t.Errorf("got %d regressions, %v, want 1", regressions, err)
+	}
+
+	if strings.Count(out.String(), "regression") != 1 {
+		t.Errorf("got comparison without a single regression marked:\n%s", out.String())
+	}
+}
+
+func TestReportRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.json")
+	report := NewReport([]Measurement{{Day: 5, Name: "x", Part: 2, Parse: time.Microsecond, Solve: time.Second}})
+
+	if err := WriteReport(path, report); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	read, err := ReadReport(path)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+
+	if len(read.Measurements) != 1 || read.Measurements[0] != report.Measurements[0] {
+		t.Errorf("got %+v, want %+v", read.Measurements, report.Measurements)
+	}
+
+	var out bytes.Buffer
+	if err := WriteTable(&out, read); err != nil {
+		t.Fatal(err)
+	}
+
+	if lines := strings.Split(strings.TrimSpace(out.String()), "\n"); len(lines) != 2 {
+		t.Errorf("got table %q, want a header and a row", out.String())
+	}
+}
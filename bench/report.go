@@ -0,0 +1,193 @@
+package bench
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+)
+
+func ReadReport(path string) (Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Report{}, fmt.Errorf("read report: %w", err)
+	}
+
+	var report Report
+	if err := json.Unmarshal(data, &report); err != nil {
+		return Report{}, fmt.Errorf("parse report %s: %w", path, err)
+	}
+
+	return report, nil
+}
+
+func WriteReport(path string, report Report) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal report: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("write report: %w", err)
+	}
+
+	return nil
+}
+
+// WriteTable prints measurements as a table.
+func WriteTable(w io.Writer, report Report) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+
+	fmt.Fprintln(tw, "day\tpart\tname\tparse\tsolve\tallocs\tallocated\tpeak\t")
+
+	for _, m := range report.Measurements {
+		fmt.Fprintf(
+			tw,
+			"%d\t%d\t%s\t%s\t%s\t%d\t%s\t%s\t\n",
+			m.Day,
+			m.Part,
+			m.Name,
+			formatParse(m.Parse),
+			formatDuration(m.Solve),
+			m.Allocs,
+			formatBytes(m.AllocBytes),
+			formatBytes(m.PeakBytes),
+		)
+	}
+
+	return tw.Flush()
+}
+
+// Change of a measurement between two reports.
+type Change struct {
+	Old Measurement
+	New Measurement
+}
+
+// Ratios returns how many times the new measurement is larger than the old one for time (parse and solve together),
+// allocations and peak memory.
+func (c Change) Ratios() (time, allocs, peak float64) {
+	return ratio(float64(c.Old.Parse+c.Old.Solve), float64(c.New.Parse+c.New.Solve)),
+		ratio(float64(c.Old.Allocs), float64(c.New.Allocs)),
+		ratio(float64(c.Old.PeakBytes), float64(c.New.PeakBytes))
+}
+
+// Regressed reports whether any of the ratios grew by more than the threshold, like 0.1 for 10%.
+func (c Change) Regressed(threshold float64) bool {
+	time, allocs, peak := c.Ratios()
+	return time > 1+threshold || allocs > 1+threshold || peak > 1+threshold
+}
+
+func ratio(before, after float64) float64 {
+	if before == 0 {
+		if after == 0 {
+			return 1
+		}
+
+		return after
+	}
+
+	return after / before
+}
+
+// Compare pairs measurements of the same parts. Parts measured in only one of the reports are skipped.
+func Compare(before, after Report) []Change {
+	type key struct {
+		day  int
+		part int
+	}
+
+	olds := make(map[key]Measurement, len(before.Measurements))
+	for _, m := range before.Measurements {
+		olds[key{day: m.Day, part: m.Part}] = m
+	}
+
+	var changes []Change
+	for _, m := range after.Measurements {
+		if o, ok := olds[key{day: m.Day, part: m.Part}]; ok {
+			changes = append(changes, Change{Old: o, New: m})
+		}
+	}
+
+	return changes
+}
+
+// WriteComparison prints changes as a table, marking regressions over the threshold. It returns the number of
+// regressions.
+func WriteComparison(w io.Writer, changes []Change, threshold float64) (int, error) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+
+	fmt.Fprintln(tw, "day\tpart\tname\told time\tnew time\tchange\told allocs\tnew allocs\tchange\told peak\tnew peak\tchange\t\t")
+
+	regressions := 0
+
+	for _, c := range changes {
+		timeRatio, allocsRatio, peakRatio := c.Ratios()
+
+		mark := ""
+		if c.Regressed(threshold) {
+			mark = "regression"
+			regressions++
+		}
+
+		fmt.Fprintf(
+			tw,
+			"%d\t%d\t%s\t%s\t%s\t%s\t%d\t%d\t%s\t%s\t%s\t%s\t%s\t\n",
+			c.New.Day,
+			c.New.Part,
+			c.New.Name,
+			formatDuration(c.Old.Parse+c.Old.Solve),
+			formatDuration(c.New.Parse+c.New.Solve),
+			formatRatio(timeRatio),
+			c.Old.Allocs,
+			c.New.Allocs,
+			formatRatio(allocsRatio),
+			formatBytes(c.Old.PeakBytes),
+			formatBytes(c.New.PeakBytes),
+			formatRatio(peakRatio),
+			mark,
+		)
+	}
+
+	return regressions, tw.Flush()
+}
+
+func formatParse(d time.Duration) string {
+	if d == 0 {
+		return "-"
+	}
+
+	return formatDuration(d)
+}
+
+func formatDuration(d time.Duration) string {
+	switch {
+	case d >= time.Second:
+		return d.Round(time.Millisecond).String()
+	case d >= time.Millisecond:
+		return d.Round(time.Microsecond).String()
+	default:
+		return d.String()
+	}
+}
+
+func formatBytes(n uint64) string {
+	const unit = 1024
+
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	value, prefix := float64(n)/unit, 0
+	for ; value >= unit && prefix < 3; prefix++ {
+		value /= unit
+	}
+
+	return fmt.Sprintf("%.1f %ciB", value, "KMGT"[prefix])
+}
+
+func formatRatio(r float64) string {
+	return fmt.Sprintf("%+.0f%%", (r-1)*100)
+}
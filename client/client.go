@@ -0,0 +1,126 @@
+// Package client talks to the Advent of Code site, or anything serving the same endpoints: it downloads puzzle inputs
+// and keeps them in a cache on disk.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// userAgent identifies the client, as the site asks automated tools to do.
+const userAgent = "github.com/harmlessevil/advent-of-code-2023"
+
+var ErrNoSession = errors.New("no session cookie, set " + EnvSession + " or \"session\" in the config file")
+
+type Client struct {
+	config Config
+	http   *http.Client
+	now    func() time.Time
+}
+
+func New(config Config) *Client {
+	return &Client{
+		config: config,
+		http:   http.DefaultClient,
+		now:    time.Now,
+	}
+}
+
+// InputPath returns the path of the cached input of the day, downloading it first if it isn't cached yet. Inputs never
+// change, so a cached one is never downloaded again.
+func (c *Client) InputPath(ctx context.Context, day int) (string, error) {
+	path := c.config.CachedInputPath(day)
+
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("check cache: %w", err)
+	}
+
+	input, err := c.download(ctx, day)
+	if err != nil {
+		return "", err
+	}
+
+	if err := writeFileAtomic(path, input); err != nil {
+		return "", fmt.Errorf("cache input: %w", err)
+	}
+
+	return path, nil
+}
+
+func (c *Client) download(ctx context.Context, day int) ([]byte, error) {
+	if c.config.Session == "" {
+		return nil, ErrNoSession
+	}
+
+	url := fmt.Sprintf("%s/%d/day/%d/input", strings.TrimSuffix(c.config.BaseURL, "/"), Year, day)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read input: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		message, _, _ := strings.Cut(strings.TrimSpace(string(body)), "\n")
+		return nil, fmt.Errorf("download input of day %d: %s: %s", day, res.Status, message)
+	}
+
+	return body, nil
+}
+
+// do sends the request on behalf of the logged-in user.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	req.Header.Set("User-Agent", userAgent)
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.config.Session})
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", req.Method, req.URL, err)
+	}
+
+	return res, nil
+}
+
+// writeFileAtomic writes the file through a temporary one, so that an interrupted download never leaves a partial input
+// in the cache.
+func writeFileAtomic(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
@@ -0,0 +1,139 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+)
+
+// newSite starts a stand-in for the site serving inputs of a single user, and counts requests to it.
+func newSite(t *testing.T, session string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var requests atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(fmt.Sprintf("/%d/day/", Year), func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != session {
+			http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+			return
+		}
+
+		var day int
+		if _, err := fmt.Sscanf(r.URL.Path, "/2023/day/%d/input", &day); err != nil || day < 1 || day > 25 {
+			http.NotFound(w, r)
+			return
+		}
+
+		fmt.Fprintf(w, "input of day %d\n", day)
+	})
+
+	site := httptest.NewServer(mux)
+	t.Cleanup(site.Close)
+
+	return site, &requests
+}
+
+func TestInputPathCaches(t *testing.T) {
+	site, requests := newSite(t, "secret")
+
+	c := New(Config{
+		BaseURL:  site.URL,
+		Session:  "secret",
+		CacheDir: t.TempDir(),
+	})
+
+	for i := 0; i < 2; i++ {
+		path, err := c.InputPath(context.Background(), 7)
+		if err != nil {
+			t.Fatalf("input path: %v", err)
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("read input: %v", err)
+		}
+
+		if string(data) != "input of day 7\n" {
+			t.Errorf("got input %q", data)
+		}
+	}
+
+	if got := requests.Load(); got != 1 {
+		t.Errorf("got %d requests, want 1", got)
+	}
+}
+
+func TestInputPathCachesBySite(t *testing.T) {
+	cacheDir := t.TempDir()
+
+	var paths []string
+	for _, session := range []string{"first", "second"} {
+		site, requests := newSite(t, session)
+
+		config := Config{
+			BaseURL:  site.URL,
+			Session:  session,
+			CacheDir: cacheDir,
+		}
+
+		path, err := New(config).InputPath(context.Background(), 7)
+		if err != nil {
+			t.Fatalf("input path: %v", err)
+		}
+
+		if got := requests.Load(); got != 1 {
+			t.Errorf("%s: got %d requests, want the input downloaded from the site", site.URL, got)
+		}
+
+		paths = append(paths, path, LedgerPath(config))
+	}
+
+	if paths[0] == paths[2] || paths[1] == paths[3] {
+		t.Errorf("got the same inputs or ledgers for both sites: %q", paths)
+	}
+}
+
+func TestInputPathDoesNotCacheErrors(t *testing.T) {
+	site, requests := newSite(t, "secret")
+
+	c := New(Config{
+		BaseURL:  site.URL,
+		Session:  "wrong",
+		CacheDir: t.TempDir(),
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.InputPath(context.Background(), 1); err == nil {
+			t.Errorf("got no error for a wrong session")
+		}
+	}
+
+	if got := requests.Load(); got != 2 {
+		t.Errorf("got %d requests, want 2", got)
+	}
+}
+
+func TestInputPathWithoutSession(t *testing.T) {
+	site, requests := newSite(t, "secret")
+
+	c := New(Config{
+		BaseURL:  site.URL,
+		CacheDir: t.TempDir(),
+	})
+
+	if _, err := c.InputPath(context.Background(), 1); !errors.Is(err, ErrNoSession) {
+		t.Errorf("got error %v, want %v", err, ErrNoSession)
+	}
+
+	if got := requests.Load(); got != 0 {
+		t.Errorf("got %d requests, want none", got)
+	}
+}
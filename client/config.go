@@ -0,0 +1,116 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	DefaultBaseURL = "https://adventofcode.com"
+	Year           = 2023
+)
+
+// Config tells where the site is and who is logged in. Empty fields take defaults.
+type Config struct {
+	BaseURL  string `json:"base_url"`
+	Session  string `json:"session"`   // value of the "session" cookie of a logged-in browser
+	CacheDir string `json:"cache_dir"` // where inputs are kept once downloaded
+}
+
+// Environment variables overriding the config file.
+const (
+	EnvBaseURL  = "AOC_BASE_URL"
+	EnvSession  = "AOC_SESSION"
+	EnvCacheDir = "AOC_CACHE_DIR"
+)
+
+// appDir names the directory of the repository under per-user config and cache directories.
+const appDir = "advent-of-code-2023"
+
+// CachedInputPath returns where the input of the day is cached, whether it's downloaded already or not.
+func (c Config) CachedInputPath(day int) string {
+	return filepath.Join(c.SiteCacheDir(), "inputs", fmt.Sprintf("day%02d.txt", day))
+}
+
+// SiteCacheDir returns the cache directory of the site at BaseURL, named after its host, so that inputs and attempts
+// of one site are never taken for another's.
+func (c Config) SiteCacheDir() string {
+	host := c.BaseURL
+	if u, err := url.Parse(c.BaseURL); err == nil && u.Host != "" {
+		host = u.Host
+	}
+
+	// Ports and anything else that isn't safe in file names, like ":" on Windows, are replaced.
+	return filepath.Join(c.CacheDir, strings.Map(func(r rune) rune {
+		if r == '.' || r == '-' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' {
+			return r
+		}
+
+		return '_'
+	}, host))
+}
+
+// ConfigPath returns the path of the config file in the per-user config directory.
+func ConfigPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("find config directory: %w", err)
+	}
+
+	return filepath.Join(dir, appDir, "config.json"), nil
+}
+
+// LoadConfig reads the config file, if there is one, and applies environment variables on top of it.
+func LoadConfig() (Config, error) {
+	path, err := ConfigPath()
+	if err != nil {
+		return Config{}, err
+	}
+
+	return loadConfig(path, os.Getenv)
+}
+
+func loadConfig(path string, getenv func(key string) string) (Config, error) {
+	var config Config
+
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return Config{}, fmt.Errorf("read config: %w", err)
+	default:
+		if err := json.Unmarshal(data, &config); err != nil {
+			return Config{}, fmt.Errorf("parse config %s: %w", path, err)
+		}
+	}
+
+	for key, field := range map[string]*string{
+		EnvBaseURL:  &config.BaseURL,
+		EnvSession:  &config.Session,
+		EnvCacheDir: &config.CacheDir,
+	} {
+		if value := getenv(key); value != "" {
+			*field = value
+		}
+	}
+
+	if config.BaseURL == "" {
+		config.BaseURL = DefaultBaseURL
+	}
+
+	if config.CacheDir == "" {
+		dir, err := os.UserCacheDir()
+		if err != nil {
+			return Config{}, fmt.Errorf("find cache directory: %w", err)
+		}
+
+		config.CacheDir = filepath.Join(dir, appDir)
+	}
+
+	return config, nil
+}
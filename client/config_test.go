@@ -0,0 +1,55 @@
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"session": "from-file", "cache_dir": "/cache"}`), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	env := map[string]string{}
+	getenv := func(key string) string {
+		return env[key]
+	}
+
+	config, err := loadConfig(path, getenv)
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+
+	want := Config{BaseURL: DefaultBaseURL, Session: "from-file", CacheDir: "/cache"}
+	if config != want {
+		t.Errorf("got %+v, want %+v", config, want)
+	}
+
+	env[EnvSession] = "from-env"
+	env[EnvBaseURL] = "http://localhost:8080"
+
+	config, err = loadConfig(path, getenv)
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+
+	want = Config{BaseURL: "http://localhost:8080", Session: "from-env", CacheDir: "/cache"}
+	if config != want {
+		t.Errorf("got %+v, want %+v", config, want)
+	}
+}
+
+func TestLoadConfigWithoutFile(t *testing.T) {
+	config, err := loadConfig(filepath.Join(t.TempDir(), "missing.json"), func(string) string {
+		return ""
+	})
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+
+	if config.BaseURL != DefaultBaseURL || config.Session != "" || config.CacheDir == "" {
+		t.Errorf("got %+v, want defaults", config)
+	}
+}
@@ -0,0 +1,145 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+var (
+	ErrKnownWrong    = errors.New("answer is known to be wrong")
+	ErrKnownCorrect  = errors.New("part is already solved")
+	ErrOutOfBounds   = errors.New("answer is outside of known bounds")
+	ErrWaitRequested = errors.New("site asked to wait before the next attempt")
+)
+
+// Attempt is an answer submitted to the site.
+type Attempt struct {
+	Day     int        `json:"day"`
+	Part    int        `json:"part"`
+	Answer  int        `json:"answer"`
+	Outcome Outcome    `json:"outcome"`
+	Time    time.Time  `json:"time"`
+	RetryAt *time.Time `json:"retry_at,omitempty"` // when the site allows the next attempt, if it asked to wait
+}
+
+// Ledger keeps every attempt in a file, so that answers the site has already judged are never submitted again.
+type Ledger struct {
+	path     string
+	Attempts []Attempt
+}
+
+// LedgerPath returns the path of the ledger in the cache directory of the site.
+func LedgerPath(config Config) string {
+	return filepath.Join(config.SiteCacheDir(), "ledger.json")
+}
+
+// OpenLedger reads the ledger from the file, or starts an empty one if there is no file yet.
+func OpenLedger(path string) (*Ledger, error) {
+	ledger := &Ledger{path: path}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return ledger, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read ledger: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &ledger.Attempts); err != nil {
+		return nil, fmt.Errorf("parse ledger %s: %w", path, err)
+	}
+
+	return ledger, nil
+}
+
+// Record adds the attempt and saves the ledger.
+func (l *Ledger) Record(attempt Attempt) error {
+	l.Attempts = append(l.Attempts, attempt)
+
+	data, err := json.MarshalIndent(l.Attempts, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal ledger: %w", err)
+	}
+
+	if err := writeFileAtomic(l.path, data); err != nil {
+		return fmt.Errorf("save ledger: %w", err)
+	}
+
+	return nil
+}
+
+// Bounds returns the range (low, high) the answer must be in, exclusive, from the hints to earlier attempts. Unknown
+// bounds are nil.
+func (l *Ledger) Bounds(day, part int) (low, high *int) {
+	for _, attempt := range l.attempts(day, part) {
+		attempt := attempt
+
+		switch {
+		case attempt.Outcome == OutcomeTooLow && (low == nil || attempt.Answer > *low):
+			low = &attempt.Answer
+		case attempt.Outcome == OutcomeTooHigh && (high == nil || attempt.Answer < *high):
+			high = &attempt.Answer
+		}
+	}
+
+	return low, high
+}
+
+// Check tells whether the answer is worth submitting at the moment. ErrOutOfBounds is the only error that doesn't mean
+// the answer is certainly wrong, in case the site changed its mind.
+func (l *Ledger) Check(day, part, answer int, now time.Time) error {
+	for _, attempt := range l.attempts(day, part) {
+		if attempt.Outcome == OutcomeCorrect {
+			return fmt.Errorf("%w with answer %d", ErrKnownCorrect, attempt.Answer)
+		}
+
+		if attempt.Answer == answer && attempt.Outcome.IsWrong() {
+			return fmt.Errorf("%w: %d was %s at %s", ErrKnownWrong, answer, attempt.Outcome, attempt.Time.Format(time.DateTime))
+		}
+	}
+
+	if retryAt := l.retryAt(); now.Before(retryAt) {
+		return fmt.Errorf("%w: %s left", ErrWaitRequested, retryAt.Sub(now).Round(time.Second))
+	}
+
+	if low, high := l.Bounds(day, part); (low != nil && answer <= *low) || (high != nil && answer >= *high) {
+		return fmt.Errorf("%w: %d is not in (%s, %s)", ErrOutOfBounds, answer, formatBound(low), formatBound(high))
+	}
+
+	return nil
+}
+
+func (l *Ledger) attempts(day, part int) []Attempt {
+	var res []Attempt
+	for _, attempt := range l.Attempts {
+		if attempt.Day == day && attempt.Part == part {
+			res = append(res, attempt)
+		}
+	}
+
+	return res
+}
+
+// retryAt returns when the site allows the next attempt. The site throttles answers to all days together.
+func (l *Ledger) retryAt() time.Time {
+	var res time.Time
+	for _, attempt := range l.Attempts {
+		if attempt.RetryAt != nil && attempt.RetryAt.After(res) {
+			res = *attempt.RetryAt
+		}
+	}
+
+	return res
+}
+
+func formatBound(bound *int) string {
+	if bound == nil {
+		return "?"
+	}
+
+	return fmt.Sprint(*bound)
+}
@@ -0,0 +1,207 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Outcome is how the site judged an answer.
+type Outcome int
+
+const (
+	OutcomeCorrect Outcome = iota + 1
+	OutcomeTooHigh
+	OutcomeTooLow
+	OutcomeWrong // wrong, without a hint which way
+	OutcomeThrottled
+	OutcomeAlreadySolved
+)
+
+var outcomeNames = map[Outcome]string{
+	OutcomeCorrect:       "correct",
+	OutcomeTooHigh:       "too high",
+	OutcomeTooLow:        "too low",
+	OutcomeWrong:         "wrong",
+	OutcomeThrottled:     "throttled",
+	OutcomeAlreadySolved: "already solved",
+}
+
+func (o Outcome) String() string {
+	if name, ok := outcomeNames[o]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("Outcome(%d)", int(o))
+}
+
+// IsWrong reports whether the answer was checked and rejected.
+func (o Outcome) IsWrong() bool {
+	return o == OutcomeTooHigh || o == OutcomeTooLow || o == OutcomeWrong
+}
+
+func (o Outcome) MarshalText() ([]byte, error) {
+	if _, ok := outcomeNames[o]; !ok {
+		return nil, fmt.Errorf("unknown outcome %d", int(o))
+	}
+
+	return []byte(o.String()), nil
+}
+
+func (o *Outcome) UnmarshalText(text []byte) error {
+	for outcome, name := range outcomeNames {
+		if name == string(text) {
+			*o = outcome
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown outcome %q", text)
+}
+
+type Verdict struct {
+	Outcome Outcome
+	Wait    time.Duration // before the next attempt, if the site asked to wait
+	Message string        // response of the site as text
+}
+
+// Submit posts the answer to the part of the day.
+func (c *Client) Submit(ctx context.Context, day, part, answer int) (Verdict, error) {
+	if c.config.Session == "" {
+		return Verdict{}, ErrNoSession
+	}
+
+	endpoint := fmt.Sprintf("%s/%d/day/%d/answer", strings.TrimSuffix(c.config.BaseURL, "/"), Year, day)
+	form := url.Values{
+		"level":  {strconv.Itoa(part)},
+		"answer": {strconv.Itoa(answer)},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return Verdict{}, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := c.do(req)
+	if err != nil {
+		return Verdict{}, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return Verdict{}, fmt.Errorf("read response: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		message, _, _ := strings.Cut(strings.TrimSpace(string(body)), "\n")
+		return Verdict{}, fmt.Errorf("submit answer of day %d part %d: %s: %s", day, part, res.Status, message)
+	}
+
+	return parseVerdict(string(body))
+}
+
+// SubmitOnce submits the answer unless the ledger tells it's pointless, and records the attempt. Answers outside of
+// known bounds are submitted only if allowed, see Ledger.Check.
+func (c *Client) SubmitOnce(ctx context.Context, ledger *Ledger, day, part, answer int, allowOutOfBounds bool) (Verdict, error) {
+	now := c.now()
+
+	if err := ledger.Check(day, part, answer, now); err != nil {
+		if !allowOutOfBounds || !errors.Is(err, ErrOutOfBounds) {
+			return Verdict{}, err
+		}
+
+		slog.Warn("submitting anyway", slog.Any("reason", err))
+	}
+
+	verdict, err := c.Submit(ctx, day, part, answer)
+	if err != nil {
+		return Verdict{}, err
+	}
+
+	attempt := Attempt{
+		Day:     day,
+		Part:    part,
+		Answer:  answer,
+		Outcome: verdict.Outcome,
+		Time:    now,
+	}
+
+	if verdict.Wait > 0 {
+		retryAt := now.Add(verdict.Wait)
+		attempt.RetryAt = &retryAt
+	}
+
+	if err := ledger.Record(attempt); err != nil {
+		return Verdict{}, err
+	}
+
+	return verdict, nil
+}
+
+var (
+	articlePattern = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagPattern     = regexp.MustCompile(`<[^>]*>`)
+	spacePattern   = regexp.MustCompile(`\s+`)
+
+	// "You have 4m 32s left to wait." after a throttled attempt.
+	leftToWaitPattern = regexp.MustCompile(`you have (?:(\d+)m )?(\d+)s left to wait`)
+	// "Please wait one minute before trying again." after a wrong answer.
+	waitPattern = regexp.MustCompile(`wait (one|\d+) minutes?`)
+)
+
+// parseVerdict reads the outcome from the page the site responds with.
+func parseVerdict(page string) (Verdict, error) {
+	text := page
+	if match := articlePattern.FindStringSubmatch(page); match != nil {
+		text = match[1]
+	}
+
+	text = html.UnescapeString(tagPattern.ReplaceAllString(text, ""))
+	text = strings.TrimSpace(spacePattern.ReplaceAllString(text, " "))
+
+	verdict := Verdict{Message: text}
+
+	lower := strings.ToLower(text)
+
+	switch {
+	case strings.Contains(lower, "that's the right answer"):
+		verdict.Outcome = OutcomeCorrect
+	case strings.Contains(lower, "your answer is too high"):
+		verdict.Outcome = OutcomeTooHigh
+	case strings.Contains(lower, "your answer is too low"):
+		verdict.Outcome = OutcomeTooLow
+	case strings.Contains(lower, "that's not the right answer"):
+		verdict.Outcome = OutcomeWrong
+	case strings.Contains(lower, "you gave an answer too recently"):
+		verdict.Outcome = OutcomeThrottled
+	case strings.Contains(lower, "did you already complete it"):
+		verdict.Outcome = OutcomeAlreadySolved
+	default:
+		return Verdict{}, fmt.Errorf("unknown response %q", text)
+	}
+
+	if match := leftToWaitPattern.FindStringSubmatch(lower); match != nil {
+		minutes, _ := strconv.Atoi(match[1]) // empty without minutes
+		seconds, _ := strconv.Atoi(match[2])
+		verdict.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	} else if match := waitPattern.FindStringSubmatch(lower); match != nil {
+		minutes := 1
+		if match[1] != "one" {
+			minutes, _ = strconv.Atoi(match[1])
+		}
+		verdict.Wait = time.Duration(minutes) * time.Minute
+	}
+
+	return verdict, nil
+}
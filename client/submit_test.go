@@ -0,0 +1,192 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// Responses of the site, trimmed to the article with the verdict.
+const (
+	pageCorrect = `<main><article><p>That's the right answer!  You are one gold star closer to restoring snow operations.` +
+		` <a href="/2023/day/1#part2">[Continue to Part Two]</a></p></article></main>`
+	pageTooHigh = `<main><article><p>That's not the right answer; your answer is too high.  If you're stuck, make sure` +
+		` you're using the full input data. Please wait one minute before trying again. [<a href="/2023/day/1">Return` +
+		` to Day 1</a>]</p></article></main>`
+	pageTooLow = `<main><article><p>That's not the right answer; your answer is too low.  Please wait 5 minutes` +
+		` before trying again.</p></article></main>`
+	pageWrong = `<main><article><p>That's not the right answer.  If you're stuck, make sure you're using the full` +
+		` input data. Please wait one minute before trying again.</p></article></main>`
+	pageThrottled = `<main><article><p>You gave an answer too recently; you have to wait after submitting an answer` +
+		` before trying again.  You have 4m 32s left to wait. [<a href="/2023/day/1">Return to Day 1</a>]</p>` +
+		`</article></main>`
+	pageAlreadySolved = `<main><article><p>You don't seem to be solving the right level.  Did you already complete` +
+		` it? [<a href="/2023/day/1">Return to Day 1</a>]</p></article></main>`
+)
+
+func TestParseVerdict(t *testing.T) {
+	cases := []struct {
+		page    string
+		outcome Outcome
+		wait    time.Duration
+	}{
+		{page: pageCorrect, outcome: OutcomeCorrect},
+		{page: pageTooHigh, outcome: OutcomeTooHigh, wait: time.Minute},
+		{page: pageTooLow, outcome: OutcomeTooLow, wait: 5 * time.Minute},
+		{page: pageWrong, outcome: OutcomeWrong, wait: time.Minute},
+		{page: pageThrottled, outcome: OutcomeThrottled, wait: 4*time.Minute + 32*time.Second},
+		{page: pageAlreadySolved, outcome: OutcomeAlreadySolved},
+	}
+
+	for _, c := range cases {
+		verdict, err := parseVerdict(c.page)
+		if err != nil {
+			t.Errorf("parse %q: %v", c.page, err)
+			continue
+		}
+
+		if verdict.Outcome != c.outcome || verdict.Wait != c.wait {
+			t.Errorf("got %v and wait %v, want %v and wait %v: %s", verdict.Outcome, verdict.Wait, c.outcome, c.wait,
+				verdict.Message)
+		}
+	}
+
+	if _, err := parseVerdict("<article><p>Something else</p></article>"); err == nil {
+		t.Errorf("got no error for an unknown response")
+	}
+}
+
+// newJudge starts a stand-in for the site judging answers to day 1 part 1, where the right answer is 142.
+func newJudge(t *testing.T) (*httptest.Server, *[]string) {
+	t.Helper()
+
+	var answers []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2023/day/1/answer", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.FormValue("level") != "1" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+
+		answers = append(answers, r.FormValue("answer"))
+
+		answer, _ := strconv.Atoi(r.FormValue("answer"))
+		switch {
+		case answer > 142:
+			fmt.Fprint(w, pageTooHigh)
+		case answer < 142:
+			fmt.Fprint(w, pageTooLow)
+		default:
+			fmt.Fprint(w, pageCorrect)
+		}
+	})
+
+	site := httptest.NewServer(mux)
+	t.Cleanup(site.Close)
+
+	return site, &answers
+}
+
+func TestSubmitOnce(t *testing.T) {
+	site, answers := newJudge(t)
+
+	c := New(Config{
+		BaseURL: site.URL,
+		Session: "secret",
+	})
+
+	now := time.Date(2023, 12, 1, 6, 0, 0, 0, time.UTC)
+	c.now = func() time.Time {
+		return now
+	}
+
+	ledger, err := OpenLedger(filepath.Join(t.TempDir(), "ledger.json"))
+	if err != nil {
+		t.Fatalf("open ledger: %v", err)
+	}
+
+	submit := func(answer int, allowOutOfBounds bool) (Outcome, error) {
+		verdict, err := c.SubmitOnce(context.Background(), ledger, 1, 1, answer, allowOutOfBounds)
+		return verdict.Outcome, err
+	}
+
+	if outcome, err := submit(200, false); err != nil || outcome != OutcomeTooHigh {
+		t.Fatalf("got %v, %v, want %v", outcome, err, OutcomeTooHigh)
+	}
+
+	if _, err := submit(100, false); !errors.Is(err, ErrWaitRequested) {
+		t.Errorf("got error %v right after a wrong answer, want %v", err, ErrWaitRequested)
+	}
+
+	now = now.Add(time.Minute)
+
+	if _, err := submit(200, false); !errors.Is(err, ErrKnownWrong) {
+		t.Errorf("got error %v for the same answer, want %v", err, ErrKnownWrong)
+	}
+
+	if _, err := submit(300, false); !errors.Is(err, ErrOutOfBounds) {
+		t.Errorf("got error %v for an answer above the known one, want %v", err, ErrOutOfBounds)
+	}
+
+	if outcome, err := submit(300, true); err != nil || outcome != OutcomeTooHigh {
+		t.Errorf("got %v, %v for an allowed answer out of bounds, want %v", outcome, err, OutcomeTooHigh)
+	}
+
+	now = now.Add(time.Minute)
+
+	if outcome, err := submit(142, false); err != nil || outcome != OutcomeCorrect {
+		t.Errorf("got %v, %v, want %v", outcome, err, OutcomeCorrect)
+	}
+
+	if _, err := submit(142, false); !errors.Is(err, ErrKnownCorrect) {
+		t.Errorf("got error %v after the right answer, want %v", err, ErrKnownCorrect)
+	}
+
+	if fmt.Sprint(*answers) != "[200 300 142]" {
+		t.Errorf("site got answers %v, want [200 300 142]", *answers)
+	}
+
+	reopened, err := OpenLedger(ledger.path)
+	if err != nil {
+		t.Fatalf("reopen ledger: %v", err)
+	}
+
+	if len(reopened.Attempts) != 3 || reopened.Attempts[2].Outcome != OutcomeCorrect {
+		t.Errorf("got attempts %+v after reopening the ledger", reopened.Attempts)
+	}
+}
+
+func TestLedgerBounds(t *testing.T) {
+	ledger := &Ledger{Attempts: []Attempt{
+		{Day: 1, Part: 1, Answer: 100, Outcome: OutcomeTooLow},
+		{Day: 1, Part: 1, Answer: 120, Outcome: OutcomeTooLow},
+		{Day: 1, Part: 1, Answer: 200, Outcome: OutcomeTooHigh},
+		{Day: 1, Part: 1, Answer: 150, Outcome: OutcomeWrong},
+		{Day: 1, Part: 2, Answer: 130, Outcome: OutcomeTooHigh},
+	}}
+
+	low, high := ledger.Bounds(1, 1)
+	if low == nil || *low != 120 || high == nil || *high != 200 {
+		t.Errorf("got bounds (%s, %s), want (120, 200)", formatBound(low), formatBound(high))
+	}
+
+	for answer, want := range map[int]error{
+		120: ErrKnownWrong,
+		121: nil,
+		150: ErrKnownWrong,
+		199: nil,
+		250: ErrOutOfBounds,
+		110: ErrOutOfBounds,
+	} {
+		if err := ledger.Check(1, 1, answer, time.Now()); !errors.Is(err, want) {
+			t.Errorf("got error %v for %d, want %v", err, answer, want)
+		}
+	}
+}
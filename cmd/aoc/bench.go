@@ -0,0 +1,111 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/harmlessevil/advent-of-code-2023/bench"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles"
+)
+
+func runBench(args []string) error {
+	flags := flag.NewFlagSet("bench", flag.ContinueOnError)
+	day := flags.Int("day", 0, "day of the puzzle to measure (1-25), all days if not set")
+	runs := flags.Int("runs", 5, "number of runs to take the best time of")
+	output := flags.String("o", "", "save the report as json to this file")
+	compare := flags.Bool("compare", false, "compare two saved reports given as arguments instead of measuring")
+	threshold := flags.Float64("threshold", 0.1, "relative growth of time or memory reported as a regression")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *compare {
+		if flags.NArg() != 2 {
+			return errors.New("usage: aoc bench -compare [-threshold T] old.json new.json")
+		}
+
+		return compareReports(flags.Arg(0), flags.Arg(1), *threshold)
+	}
+
+	if *runs < 1 {
+		return fmt.Errorf("invalid number of runs %d", *runs)
+	}
+
+	selected := puzzles.All()
+	if *day != 0 {
+		puzzle, err := puzzles.Lookup(*day)
+		if err != nil {
+			return err
+		}
+
+		selected = []puzzles.Puzzle{puzzle}
+	}
+
+	var measurements []bench.Measurement
+
+	for _, puzzle := range selected {
+		path, err := fetchInput(puzzle.Day)
+		if err != nil {
+			return err
+		}
+
+		input, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read input: %w", err)
+		}
+
+		for part := 1; part <= 2; part++ {
+			if _, err := puzzle.Part(part); err != nil {
+				continue // the last day has a single part
+			}
+
+			m, err := bench.Measure(puzzle, part, input, *runs)
+			if err != nil {
+				slog.Warn("skipping part", slog.Int("day", puzzle.Day), slog.Int("part", part), slog.Any("error", err))
+				continue
+			}
+
+			measurements = append(measurements, m)
+		}
+	}
+
+	report := bench.NewReport(measurements)
+
+	if err := bench.WriteTable(os.Stdout, report); err != nil {
+		return err
+	}
+
+	if *output != "" {
+		return bench.WriteReport(*output, report)
+	}
+
+	return nil
+}
+
+// compareReports prints the changes between two saved reports, failing if any part regressed.
+func compareReports(oldPath, newPath string, threshold float64) error {
+	old, err := bench.ReadReport(oldPath)
+	if err != nil {
+		return err
+	}
+
+	current, err := bench.ReadReport(newPath)
+	if err != nil {
+		return err
+	}
+
+	regressions, err := bench.WriteComparison(os.Stdout, bench.Compare(old, current), threshold)
+	if err != nil {
+		return err
+	}
+
+	if regressions > 0 {
+		return fmt.Errorf("%d parts regressed by more than %.0f%%", regressions, threshold*100)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+// Command aoc runs solvers for Advent of Code 2023 puzzles.
+//
+// Usage:
+//
+//	aoc run -day N [-part 1|2] [-format text|json|csv | -explain] [input]
+//	aoc fetch -day N
+//	aoc submit -day N [-part 1|2] [-force] [input]
+//	aoc list
+//	aoc bench [-day N] [-runs N] [-o report.json]
+//	aoc bench -compare [-threshold T] old.json new.json
+//
+// Without an input, the input of the day is downloaded from the site once and cached, see package client; "-" reads it
+// from stdin.
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+)
+
+var logLevel slog.LevelVar
+
+var errUsage = errors.New("usage: aoc <run|fetch|submit|list|bench> [flags]")
+
+func runMain(args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	switch args[0] {
+	case "run":
+		return runSolve(args[1:])
+	case "fetch":
+		return runFetch(args[1:])
+	case "submit":
+		return runSubmit(args[1:])
+	case "list":
+		return runList(args[1:])
+	case "bench":
+		return runBench(args[1:])
+	}
+
+	return fmt.Errorf("unknown command %q: %w", args[0], errUsage)
+}
+
+func main() {
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+		Level: &logLevel,
+	})))
+
+	if err := runMain(os.Args[1:]); err != nil {
+		slog.Error("program aborted", slog.Any("error", err))
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/harmlessevil/advent-of-code-2023/client"
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles"
+)
+
+func runSolve(args []string) error {
+	flags := flag.NewFlagSet("run", flag.ContinueOnError)
+	day := flags.Int("day", 0, "day of the puzzle to solve (1-25)")
+	part := flags.Int("part", 1, "part of the puzzle to solve (1 or 2)")
+	verbose := flags.Bool("v", false, "enable debug logging")
+	format := flags.String("format", "text", "output format: text prints the answer, json adds timing and diagnostics, csv prints diagnostics of days supporting it")
+	explain := flags.Bool("explain", false, "print how the answer was found instead of it, for days supporting it")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *verbose {
+		logLevel.Set(slog.LevelDebug)
+	}
+
+	if *format != "text" && *format != "json" && *format != "csv" {
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	if *explain && *format != "text" {
+		return errors.New("-explain prints text, it can't be used with -format")
+	}
+
+	puzzle, err := puzzles.Lookup(*day)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+
+	// Diagnostics can cost more than the answer, so they're built only for outputs showing them.
+	result, err := solve(puzzle, *part, flags.Arg(0), *explain || *format != "text")
+	if err != nil {
+		return err
+	}
+
+	elapsed := time.Since(start)
+
+	switch {
+	case *explain:
+		diagnostics, ok := result.Diagnostics.(explainer)
+		if !ok {
+			return fmt.Errorf("day %d part %d has no explanation", puzzle.Day, *part)
+		}
+
+		return diagnostics.Explain(os.Stdout)
+	case *format == "text":
+		fmt.Println(result.Answer)
+		return nil
+	case *format == "csv":
+		diagnostics, ok := result.Diagnostics.(csvWriter)
+		if !ok {
+			return fmt.Errorf("day %d part %d has no csv diagnostics", puzzle.Day, *part)
+		}
+
+		return diagnostics.WriteCSV(os.Stdout)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(output{
+		Day:         puzzle.Day,
+		Name:        puzzle.Name,
+		Part:        *part,
+		Answer:      result.Answer,
+		TimeNS:      elapsed.Nanoseconds(),
+		Diagnostics: result.Diagnostics,
+	})
+}
+
+// output is the result of a solver in the json format. The time includes parsing the input, and downloading it if
+// needed.
+type output struct {
+	Day         int    `json:"day"`
+	Name        string `json:"name"`
+	Part        int    `json:"part"`
+	Answer      int    `json:"answer"`
+	TimeNS      int64  `json:"time_ns"`
+	Diagnostics any    `json:"diagnostics,omitempty"`
+}
+
+// csvWriter is implemented by diagnostics exportable as csv.
+type csvWriter interface {
+	WriteCSV(w io.Writer) error
+}
+
+// explainer is implemented by diagnostics that can tell how the answer was found in plain text.
+type explainer interface {
+	Explain(w io.Writer) error
+}
+
+// solve runs the solver of the part on the input at inputPath, or on the input fetched from the site if the path is
+// empty. Diagnostics are only built if diagnose is set.
+func solve(puzzle puzzles.Puzzle, part int, inputPath string, diagnose bool) (puzzles.Result, error) {
+	solver, err := puzzle.Part(part)
+	if err != nil {
+		return puzzles.Result{}, err
+	}
+
+	if inputPath == "" {
+		if inputPath, err = fetchInput(puzzle.Day); err != nil {
+			return puzzles.Result{}, err
+		}
+	}
+
+	input, err := openInput(inputPath)
+	if err != nil {
+		return puzzles.Result{}, fmt.Errorf("open input: %w", err)
+	}
+	defer input.Close()
+
+	var result puzzles.Result
+	if diagnose {
+		result, err = puzzles.Diagnose(solver, input)
+	} else {
+		result, err = solver.Solve(input)
+	}
+
+	if err != nil {
+		// Messages of wrapping errors are already formatted, so report the position in the input on its own.
+		var parseErr *parse.Error
+		if errors.As(err, &parseErr) {
+			parseErr.File = inputName(inputPath)
+			err = parseErr
+		}
+
+		return puzzles.Result{}, fmt.Errorf("solve day %d (%s) part %d: %w", puzzle.Day, puzzle.Name, part, err)
+	}
+
+	return result, nil
+}
+
+func runFetch(args []string) error {
+	flags := flag.NewFlagSet("fetch", flag.ContinueOnError)
+	day := flags.Int("day", 0, "day of the puzzle to fetch the input of (1-25)")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	puzzle, err := puzzles.Lookup(*day)
+	if err != nil {
+		return err
+	}
+
+	path, err := fetchInput(puzzle.Day)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(path)
+
+	return nil
+}
+
+// fetchInput returns the path of the cached input of the day, downloading it if needed.
+func fetchInput(day int) (string, error) {
+	config, err := client.LoadConfig()
+	if err != nil {
+		return "", fmt.Errorf("load config: %w", err)
+	}
+
+	path, err := client.New(config).InputPath(context.Background(), day)
+	if err != nil {
+		return "", fmt.Errorf("fetch input: %w", err)
+	}
+
+	return path, nil
+}
+
+func runList(args []string) error {
+	flags := flag.NewFlagSet("list", flag.ContinueOnError)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	for _, puzzle := range puzzles.All() {
+		fmt.Printf("%2d %s\n", puzzle.Day, puzzle.Name)
+	}
+
+	return nil
+}
+
+// openInput opens the file at path, or stdin if path is "-".
+func openInput(path string) (io.ReadCloser, error) {
+	if path == "-" {
+		return io.NopCloser(os.Stdin), nil
+	}
+
+	return os.Open(path)
+}
+
+// inputName names the input in parse errors.
+func inputName(path string) string {
+	if path == "-" {
+		return "stdin"
+	}
+
+	return path
+}
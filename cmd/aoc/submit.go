@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/harmlessevil/advent-of-code-2023/client"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles"
+)
+
+func runSubmit(args []string) error {
+	flags := flag.NewFlagSet("submit", flag.ContinueOnError)
+	day := flags.Int("day", 0, "day of the puzzle to solve (1-25)")
+	part := flags.Int("part", 1, "part of the puzzle to solve (1 or 2)")
+	force := flags.Bool("force", false, "submit even if the answer is outside of bounds known from earlier attempts")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	puzzle, err := puzzles.Lookup(*day)
+	if err != nil {
+		return err
+	}
+
+	result, err := solve(puzzle, *part, flags.Arg(0), false)
+	if err != nil {
+		return err
+	}
+
+	answer := result.Answer
+
+	config, err := client.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+
+	ledger, err := client.OpenLedger(client.LedgerPath(config))
+	if err != nil {
+		return err
+	}
+
+	verdict, err := client.New(config).SubmitOnce(context.Background(), ledger, *day, *part, answer, *force)
+	if err != nil {
+		return fmt.Errorf("submit %d: %w", answer, err)
+	}
+
+	fmt.Printf("%d: %s\n", answer, verdict.Outcome)
+	if verdict.Wait > 0 {
+		fmt.Printf("wait %s before the next attempt\n", verdict.Wait)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package grid
+
+type Direction int
+
+const (
+	DirectionRight Direction = iota
+	DirectionDown
+	DirectionLeft
+	DirectionUp
+)
+
+// Directions lists all directions clockwise, starting from the right.
+var Directions = [4]Direction{DirectionRight, DirectionDown, DirectionLeft, DirectionUp}
+
+func (d Direction) ToVector() Point {
+	switch d {
+	case DirectionRight:
+		return Point{X: 1, Y: 0}
+	case DirectionDown:
+		return Point{X: 0, Y: 1}
+	case DirectionLeft:
+		return Point{X: -1, Y: 0}
+	case DirectionUp:
+		return Point{X: 0, Y: -1}
+	}
+
+	return Point{}
+}
+
+func (d Direction) Reverse() Direction {
+	return (d + 2) % 4
+}
+
+func (d Direction) TurnRight() Direction {
+	return (d + 1) % 4
+}
+
+func (d Direction) TurnLeft() Direction {
+	return (d + 3) % 4
+}
@@ -0,0 +1,228 @@
+// Package grid provides a dense two-dimensional grid of tiles, points and directions to walk it.
+package grid
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+)
+
+// Grid stores tiles row by row. Like a slice, a copy of the grid shares tiles with the original.
+type Grid[T any] struct {
+	Width  int
+	Height int
+	tiles  []T
+}
+
+func New[T any](width, height int) Grid[T] {
+	return Grid[T]{
+		Width:  width,
+		Height: height,
+		tiles:  make([]T, width*height),
+	}
+}
+
+// Parse reads the grid from r, see Read.
+func Parse[T any](r io.Reader, parseTile func(point Point, symbol rune) (T, error)) (Grid[T], error) {
+	return Read(parse.NewScanner(r), parseTile)
+}
+
+// ParseRunes reads the grid of symbols as they are.
+func ParseRunes(r io.Reader) (Grid[rune], error) {
+	return Parse(r, func(_ Point, symbol rune) (rune, error) {
+		return symbol, nil
+	})
+}
+
+// Read reads lines of the grid until an empty line or the end of input, converting every symbol into a tile.
+// Errors are reported as *parse.Error.
+func Read[T any](scanner *parse.Scanner, parseTile func(point Point, symbol rune) (T, error)) (Grid[T], error) {
+	var g Grid[T]
+
+	for scanner.Scan() {
+		line := []rune(scanner.Text())
+		if len(line) == 0 {
+			break
+		}
+
+		if g.Height == 0 {
+			g.Width = len(line)
+		} else if len(line) != g.Width {
+			return Grid[T]{}, scanner.Errorf(0, "row has %d tiles, expected %d", len(line), g.Width)
+		}
+
+		for x, symbol := range line {
+			tile, err := parseTile(Point{X: x, Y: g.Height}, symbol)
+			if err != nil {
+				return Grid[T]{}, scanner.Errorf(x+1, "parse tile %q: %w", symbol, err)
+			}
+
+			g.tiles = append(g.tiles, tile)
+		}
+
+		g.Height++
+	}
+
+	if err := scanner.Err(); err != nil {
+		return Grid[T]{}, fmt.Errorf("scan: %w", err)
+	}
+
+	if g.Height == 0 {
+		return Grid[T]{}, scanner.Errorf(0, "expected a grid")
+	}
+
+	return g, nil
+}
+
+func (g Grid[T]) InBounds(p Point) bool {
+	return p.Y >= 0 && p.Y < g.Height && p.X >= 0 && p.X < g.Width
+}
+
+func (g Grid[T]) At(p Point) T {
+	return g.tiles[p.Y*g.Width+p.X]
+}
+
+// AtWrapped treats the grid as infinitely repeated in every direction.
+func (g Grid[T]) AtWrapped(p Point) T {
+	return g.At(Point{
+		X: mod(p.X, g.Width),
+		Y: mod(p.Y, g.Height),
+	})
+}
+
+func (g Grid[T]) Set(p Point, tile T) {
+	g.tiles[p.Y*g.Width+p.X] = tile
+}
+
+// Ref returns a pointer to the tile at p, to update it in place.
+func (g Grid[T]) Ref(p Point) *T {
+	return &g.tiles[p.Y*g.Width+p.X]
+}
+
+// Row returns tiles of the row y. Changes to them are reflected in the grid.
+func (g Grid[T]) Row(y int) []T {
+	return g.tiles[y*g.Width : (y+1)*g.Width]
+}
+
+// Points returns every point of the grid, row by row.
+func (g Grid[T]) Points() []Point {
+	res := make([]Point, 0, len(g.tiles))
+	for y := 0; y < g.Height; y++ {
+		for x := 0; x < g.Width; x++ {
+			res = append(res, Point{X: x, Y: y})
+		}
+	}
+
+	return res
+}
+
+// Neighbors4 returns points of the grid sharing a side with p.
+func (g Grid[T]) Neighbors4(p Point) []Point {
+	neighbors := p.Neighbors4()
+	return g.filterInBounds(neighbors[:])
+}
+
+// Neighbors8 returns points of the grid sharing a side or a corner with p.
+func (g Grid[T]) Neighbors8(p Point) []Point {
+	neighbors := p.Neighbors8()
+	return g.filterInBounds(neighbors[:])
+}
+
+func (g Grid[T]) filterInBounds(points []Point) []Point {
+	res := points[:0]
+	for _, point := range points {
+		if g.InBounds(point) {
+			res = append(res, point)
+		}
+	}
+
+	return res
+}
+
+func (g Grid[T]) Clone() Grid[T] {
+	return Grid[T]{
+		Width:  g.Width,
+		Height: g.Height,
+		tiles:  append([]T(nil), g.tiles...),
+	}
+}
+
+// Transpose flips the grid over its main diagonal, so that rows become columns.
+func (g Grid[T]) Transpose() Grid[T] {
+	return g.remap(g.Height, g.Width, func(p Point) Point {
+		return Point{X: p.Y, Y: p.X}
+	})
+}
+
+func (g Grid[T]) RotateClockwise() Grid[T] {
+	return g.remap(g.Height, g.Width, func(p Point) Point {
+		return Point{X: g.Height - 1 - p.Y, Y: p.X}
+	})
+}
+
+func (g Grid[T]) RotateCounterClockwise() Grid[T] {
+	return g.remap(g.Height, g.Width, func(p Point) Point {
+		return Point{X: p.Y, Y: g.Width - 1 - p.X}
+	})
+}
+
+// remap creates a grid of the given size, moving every tile from its point p to the point to(p).
+func (g Grid[T]) remap(width, height int, to func(p Point) Point) Grid[T] {
+	res := New[T](width, height)
+	for y := 0; y < g.Height; y++ {
+		for x := 0; x < g.Width; x++ {
+			p := Point{X: x, Y: y}
+			res.Set(to(p), g.At(p))
+		}
+	}
+
+	return res
+}
+
+// Format renders the grid row by row, converting every tile into a symbol.
+func (g Grid[T]) Format(render func(tile T) rune) string {
+	var b strings.Builder
+	for y := 0; y < g.Height; y++ {
+		if y > 0 {
+			b.WriteRune('\n')
+		}
+
+		for _, tile := range g.Row(y) {
+			b.WriteRune(render(tile))
+		}
+	}
+
+	return b.String()
+}
+
+// String renders a row per line. Runes are written as they are, like with Format, and other tiles with fmt.Sprint,
+// separated by spaces so that tiles like 1 and 23 don't read as 12 and 3. Use Format to draw them as symbols instead.
+func (g Grid[T]) String() string {
+	var b strings.Builder
+	for y := 0; y < g.Height; y++ {
+		if y > 0 {
+			b.WriteRune('\n')
+		}
+
+		for x, tile := range g.Row(y) {
+			if symbol, ok := any(tile).(rune); ok {
+				b.WriteRune(symbol)
+				continue
+			}
+
+			if x > 0 {
+				b.WriteRune(' ')
+			}
+
+			b.WriteString(fmt.Sprint(tile))
+		}
+	}
+
+	return b.String()
+}
+
+func mod(a, b int) int {
+	return (a%b + b) % b
+}
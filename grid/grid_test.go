@@ -0,0 +1,207 @@
+package grid
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+)
+
+const example = "abc\ndef"
+
+func parseExample(t *testing.T) Grid[rune] {
+	t.Helper()
+
+	g, err := ParseRunes(strings.NewReader(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return g
+}
+
+func TestIndexing(t *testing.T) {
+	g := parseExample(t)
+
+	if g.Width != 3 || g.Height != 2 {
+		t.Fatalf("got size %dx%d, want 3x2", g.Width, g.Height)
+	}
+
+	if got := g.At(Point{X: 2, Y: 1}); got != 'f' {
+		t.Errorf("got %q at 2,1, want 'f'", got)
+	}
+
+	if got := g.AtWrapped(Point{X: -1, Y: 3}); got != 'f' {
+		t.Errorf("got %q at -1,3 wrapped, want 'f'", got)
+	}
+
+	clone := g.Clone()
+
+	g.Set(Point{X: 0, Y: 0}, 'x')
+	*g.Ref(Point{X: 1, Y: 0}) = 'y'
+	g.Row(1)[2] = 'z'
+
+	if got := g.String(); got != "xyc\ndez" {
+		t.Errorf("got grid\n%s\nafter updates", got)
+	}
+
+	if got := clone.String(); got != example {
+		t.Errorf("got clone\n%s\nchanged with the original", got)
+	}
+
+	points := g.Points()
+	if len(points) != 6 || points[0] != (Point{X: 0, Y: 0}) || points[5] != (Point{X: 2, Y: 1}) {
+		t.Errorf("got points %v", points)
+	}
+}
+
+func TestBounds(t *testing.T) {
+	g := New[int](3, 2)
+
+	for point, want := range map[Point]bool{
+		{X: 0, Y: 0}:  true,
+		{X: 2, Y: 1}:  true,
+		{X: 3, Y: 0}:  false,
+		{X: 0, Y: 2}:  false,
+		{X: -1, Y: 0}: false,
+		{X: 0, Y: -1}: false,
+	} {
+		if got := g.InBounds(point); got != want {
+			t.Errorf("%v: got in bounds %t, want %t", point, got, want)
+		}
+	}
+
+	corner := Point{X: 0, Y: 0}
+	if got, want := g.Neighbors4(corner), []Point{{X: 1, Y: 0}, {X: 0, Y: 1}}; !slices.Equal(got, want) {
+		t.Errorf("got neighbors %v of the corner, want %v", got, want)
+	}
+
+	if got, want := g.Neighbors8(corner), []Point{{X: 1, Y: 0}, {X: 0, Y: 1}, {X: 1, Y: 1}}; !slices.Equal(got, want) {
+		t.Errorf("got neighbors %v of the corner, want %v", got, want)
+	}
+
+	if got := g.Neighbors8(Point{X: 1, Y: 0}); len(got) != 5 {
+		t.Errorf("got neighbors %v of the middle of the top row, want 5", got)
+	}
+}
+
+func TestRotation(t *testing.T) {
+	g := parseExample(t)
+
+	for _, test := range []struct {
+		name string
+		got  Grid[rune]
+		want string
+	}{
+		{name: "clockwise", got: g.RotateClockwise(), want: "da\neb\nfc"},
+		{name: "counter-clockwise", got: g.RotateCounterClockwise(), want: "cf\nbe\nad"},
+		{name: "transposed", got: g.Transpose(), want: "ad\nbe\ncf"},
+		{name: "turned back", got: g.RotateClockwise().RotateCounterClockwise(), want: example},
+		{name: "turned around", got: g.RotateClockwise().RotateClockwise(), want: "fed\ncba"},
+	} {
+		if got := test.got.String(); got != test.want {
+			t.Errorf("%s: got\n%s\nwant\n%s", test.name, got, test.want)
+		}
+	}
+
+	full := g
+	for i := 0; i < 4; i++ {
+		full = full.RotateCounterClockwise()
+	}
+
+	if got := full.String(); got != example {
+		t.Errorf("got\n%s\nafter a full turn", got)
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	input := "#..\n.#.\n..#\n"
+
+	g, err := Parse(strings.NewReader(input), func(_ Point, symbol rune) (bool, error) {
+		switch symbol {
+		case '#':
+			return true, nil
+		case '.':
+			return false, nil
+		}
+
+		return false, fmt.Errorf("unknown symbol")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	formatted := g.Format(func(rock bool) rune {
+		if rock {
+			return '#'
+		}
+
+		return '.'
+	})
+
+	if formatted+"\n" != input {
+		t.Errorf("got\n%s\nwant\n%s", formatted, input)
+	}
+
+	numbers := New[int](2, 2)
+	numbers.Set(Point{X: 0, Y: 0}, 1)
+	numbers.Set(Point{X: 1, Y: 0}, 23)
+	numbers.Set(Point{X: 0, Y: 1}, 12)
+	numbers.Set(Point{X: 1, Y: 1}, 3)
+
+	if got, want := numbers.String(), "1 23\n12 3"; got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+
+	// Read stops at an empty line, leaving the rest to the caller.
+	scanner := parse.NewScanner(strings.NewReader("ab\ncd\n\nrest\n"))
+
+	first, err := Read(scanner, func(_ Point, symbol rune) (rune, error) {
+		return symbol, nil
+	})
+	if err != nil || first.String() != "ab\ncd" || !scanner.Scan() || scanner.Text() != "rest" {
+		t.Errorf("got %q, %v, then %q", first, err, scanner.Text())
+	}
+
+	for input, column := range map[string]int{"ab\nabc\n": 0, "ab\nax\n": 2, "": 0} {
+		_, err := Parse(strings.NewReader(input), func(_ Point, symbol rune) (rune, error) {
+			if symbol == 'x' {
+				return 0, errors.New("unknown symbol")
+			}
+
+			return symbol, nil
+		})
+
+		var parseErr *parse.Error
+		if !errors.As(err, &parseErr) || parseErr.Column != column {
+			t.Errorf("%q: got %v, want an error at column %d", input, err, column)
+		}
+	}
+}
+
+func TestDirections(t *testing.T) {
+	for i, direction := range Directions {
+		right := Directions[(i+1)%len(Directions)]
+
+		if got := direction.TurnRight(); got != right {
+			t.Errorf("%d: got %d turning right, want %d", direction, got, right)
+		}
+
+		if got := right.TurnLeft(); got != direction {
+			t.Errorf("%d: got %d turning left, want %d", right, got, direction)
+		}
+
+		if got := direction.Reverse(); got != direction.TurnRight().TurnRight() {
+			t.Errorf("%d: got %d reversed", direction, got)
+		}
+
+		// With y growing downwards, turning right rotates vectors clockwise.
+		v := direction.ToVector()
+		if got, want := right.ToVector(), (Point{X: -v.Y, Y: v.X}); got != want {
+			t.Errorf("%d: got vector %v turning right, want %v", direction, got, want)
+		}
+	}
+}
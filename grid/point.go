@@ -0,0 +1,46 @@
+package grid
+
+type Point struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+func (p Point) Add(other Point) Point {
+	return Point{
+		X: p.X + other.X,
+		Y: p.Y + other.Y,
+	}
+}
+
+func (p Point) Sub(other Point) Point {
+	return Point{
+		X: p.X - other.X,
+		Y: p.Y - other.Y,
+	}
+}
+
+func (p Point) Mul(scalar int) Point {
+	return Point{
+		X: p.X * scalar,
+		Y: p.Y * scalar,
+	}
+}
+
+// Neighbors4 returns points sharing a side with p, in the order of Directions.
+func (p Point) Neighbors4() [4]Point {
+	var res [4]Point
+	for i, direction := range Directions {
+		res[i] = p.Add(direction.ToVector())
+	}
+
+	return res
+}
+
+// Neighbors8 returns points sharing a side or a corner with p, row by row.
+func (p Point) Neighbors8() [8]Point {
+	return [8]Point{
+		{X: p.X - 1, Y: p.Y - 1}, {X: p.X, Y: p.Y - 1}, {X: p.X + 1, Y: p.Y - 1},
+		{X: p.X - 1, Y: p.Y}, {X: p.X + 1, Y: p.Y},
+		{X: p.X - 1, Y: p.Y + 1}, {X: p.X, Y: p.Y + 1}, {X: p.X + 1, Y: p.Y + 1},
+	}
+}
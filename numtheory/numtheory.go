@@ -0,0 +1,184 @@
+// Package numtheory provides number theory on int64 that reports overflows instead of wrapping around. Results that
+// don't fit are computed with math/big and returned in the error.
+package numtheory
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// OverflowError is returned when a result doesn't fit in int64.
+type OverflowError struct {
+	Op    string
+	Exact *big.Int
+}
+
+func (e *OverflowError) Error() string {
+	return fmt.Sprintf("%s overflows int64, the exact result is %s", e.Op, e.Exact)
+}
+
+func overflow(op string, exact *big.Int) (int64, error) {
+	return 0, &OverflowError{Op: op, Exact: exact}
+}
+
+func Add(a, b int64) (int64, error) {
+	sum := a + b
+	if (sum > a) != (b > 0) {
+		return overflow("add", new(big.Int).Add(big.NewInt(a), big.NewInt(b)))
+	}
+
+	return sum, nil
+}
+
+func Mul(a, b int64) (int64, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+
+	product := a * b
+	if product/b != a || a == -1 && b == math.MinInt64 || b == -1 && a == math.MinInt64 {
+		return overflow("mul", new(big.Int).Mul(big.NewInt(a), big.NewInt(b)))
+	}
+
+	return product, nil
+}
+
+// GCD returns the greatest common divisor of absolute values of a and b, zero if both are zero.
+func GCD(a, b int64) (int64, error) {
+	x, y := abs(a), abs(b)
+	for y != 0 {
+		x, y = y, x%y
+	}
+
+	if x > math.MaxInt64 {
+		return overflow("gcd", new(big.Int).SetUint64(x))
+	}
+
+	return int64(x), nil
+}
+
+// LCM returns the least common multiple of absolute values of a and b, zero if either is zero.
+func LCM(a, b int64) (int64, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+
+	g, err := GCD(a, b)
+	if err != nil {
+		return overflow("lcm", bigLCM([]int64{a, b}))
+	}
+
+	lcm, err := Mul(a/g, b)
+	if err == nil && lcm < 0 {
+		lcm = -lcm
+	}
+
+	// The negation overflows too if the product is math.MinInt64.
+	if err != nil || lcm < 0 {
+		return overflow("lcm", bigLCM([]int64{a, b}))
+	}
+
+	return lcm, nil
+}
+
+// GCDOf returns the greatest common divisor of all numbers, zero if there are none.
+func GCDOf(numbers []int64) (int64, error) {
+	var res int64
+	for _, n := range numbers {
+		var err error
+		if res, err = GCD(res, n); err != nil {
+			return 0, err
+		}
+	}
+
+	return res, nil
+}
+
+// LCMOf returns the least common multiple of all numbers, one if there are none.
+func LCMOf(numbers []int64) (int64, error) {
+	res := int64(1)
+	for _, n := range numbers {
+		var err error
+		if res, err = LCM(res, n); err != nil {
+			return overflow("lcm", bigLCM(numbers))
+		}
+	}
+
+	return res, nil
+}
+
+// ExtendedGCD returns the gcd of a and b, like GCD, and Bézout coefficients x and y such that a*x + b*y = gcd.
+func ExtendedGCD(a, b int64) (gcd, x, y int64, err error) {
+	bigX, bigY := new(big.Int), new(big.Int)
+	bigGCD := new(big.Int).GCD(bigX, bigY, big.NewInt(a), big.NewInt(b))
+
+	for _, n := range []*big.Int{bigGCD, bigX, bigY} {
+		if !n.IsInt64() {
+			return 0, 0, 0, &OverflowError{Op: "extended gcd", Exact: n}
+		}
+	}
+
+	return bigGCD.Int64(), bigX.Int64(), bigY.Int64(), nil
+}
+
+// CRT solves x ≡ r1 (mod m1) and x ≡ r2 (mod m2) for positive moduli, by the Chinese remainder theorem generalised to
+// moduli that aren't coprime. It returns the solution as x ≡ r (mod m) with 0 <= r < m, the modulus being the lcm of
+// both. There is no solution if residues differ modulo the gcd of the moduli.
+func CRT(r1, m1, r2, m2 int64) (r, m int64, ok bool, err error) {
+	if m1 <= 0 || m2 <= 0 {
+		return 0, 0, false, fmt.Errorf("moduli %d and %d must be positive", m1, m2)
+	}
+
+	bigM1, bigM2 := big.NewInt(m1), big.NewInt(m2)
+
+	p := new(big.Int)
+	g := new(big.Int).GCD(p, nil, bigM1, bigM2)
+
+	diff := new(big.Int).Sub(big.NewInt(r2), big.NewInt(r1))
+
+	quotient, remainder := new(big.Int).QuoRem(diff, g, new(big.Int))
+	if remainder.Sign() != 0 {
+		return 0, 0, false, nil
+	}
+
+	// r1 + m1*t solves both for t ≡ diff/g * p (mod m2/g), p being the inverse of m1/g modulo m2/g.
+	t := quotient.Mul(quotient, p)
+	t.Mod(t, new(big.Int).Quo(bigM2, g))
+
+	modulus := new(big.Int).Quo(bigM1, g)
+	modulus.Mul(modulus, bigM2)
+
+	if !modulus.IsInt64() {
+		return 0, 0, false, &OverflowError{Op: "crt", Exact: modulus}
+	}
+
+	residue := t.Mul(t, bigM1)
+	residue.Add(residue, big.NewInt(r1))
+	residue.Mod(residue, modulus)
+
+	return residue.Int64(), modulus.Int64(), true, nil
+}
+
+func abs(n int64) uint64 {
+	if n < 0 {
+		return uint64(-(n + 1)) + 1
+	}
+
+	return uint64(n)
+}
+
+func bigLCM(numbers []int64) *big.Int {
+	res := big.NewInt(1)
+	for _, n := range numbers {
+		if n == 0 {
+			return new(big.Int)
+		}
+
+		b := new(big.Int).Abs(big.NewInt(n))
+		g := new(big.Int).GCD(nil, nil, res, b)
+		res.Mul(res.Quo(res, g), b)
+	}
+
+	return res
+}
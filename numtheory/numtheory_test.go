@@ -0,0 +1,131 @@
+package numtheory
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// TestAgainstBig compares results with math/big on random numbers, small ones and ones near the limits of int64.
+func TestAgainstBig(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	random := func() int64 {
+		switch rng.Intn(4) {
+		case 0:
+			return rng.Int63n(201) - 100
+		case 1:
+			return rng.Int63n(1<<32) - 1<<31
+		case 2:
+			return math.MaxInt64 - rng.Int63n(3)
+		default:
+			return math.MinInt64 + rng.Int63n(3)
+		}
+	}
+
+	check := func(op string, a, b int64, got int64, err error, want *big.Int) {
+		t.Helper()
+
+		if want.IsInt64() {
+			if err != nil || got != want.Int64() {
+				t.Errorf("%s(%d, %d): got %d, %v, want %s", op, a, b, got, err, want)
+			}
+
+			return
+		}
+
+		var overflowErr *OverflowError
+		if !errors.As(err, &overflowErr) || overflowErr.Exact.Cmp(want) != 0 {
+			t.Errorf("%s(%d, %d): got %d, %v, want an overflow of %s", op, a, b, got, err, want)
+		}
+	}
+
+	for i := 0; i < 10000; i++ {
+		a, b := random(), random()
+		bigA, bigB := big.NewInt(a), big.NewInt(b)
+
+		got, err := Add(a, b)
+		check("Add", a, b, got, err, new(big.Int).Add(bigA, bigB))
+
+		got, err = Mul(a, b)
+		check("Mul", a, b, got, err, new(big.Int).Mul(bigA, bigB))
+
+		gcd := new(big.Int).GCD(nil, nil, bigA, bigB)
+
+		got, err = GCD(a, b)
+		check("GCD", a, b, got, err, gcd)
+
+		lcm := new(big.Int)
+		if gcd.Sign() != 0 {
+			lcm.Abs(lcm.Mul(bigA, bigB)).Quo(lcm, gcd)
+		}
+
+		got, err = LCM(a, b)
+		check("LCM", a, b, got, err, lcm)
+
+		if g, x, y, err := ExtendedGCD(a, b); err == nil {
+			sum := new(big.Int).Add(new(big.Int).Mul(bigA, big.NewInt(x)), new(big.Int).Mul(bigB, big.NewInt(y)))
+			if g != gcd.Int64() || sum.Cmp(gcd) != 0 {
+				t.Errorf("ExtendedGCD(%d, %d): got %d, %d, %d", a, b, g, x, y)
+			}
+		} else if gcd.IsInt64() {
+			t.Errorf("ExtendedGCD(%d, %d): %v", a, b, err)
+		}
+	}
+}
+
+func TestOf(t *testing.T) {
+	if got, err := LCMOf([]int64{4, 6, 10}); err != nil || got != 60 {
+		t.Errorf("got lcm %d, %v, want 60", got, err)
+	}
+
+	if got, err := GCDOf([]int64{12, -18, 30}); err != nil || got != 6 {
+		t.Errorf("got gcd %d, %v, want 6", got, err)
+	}
+
+	if got, err := LCMOf(nil); err != nil || got != 1 {
+		t.Errorf("got lcm of nothing %d, %v, want 1", got, err)
+	}
+
+	// Primes whose product doesn't fit, though every partial product of the first ones does.
+	primes := []int64{1000000007, 1000000009, 1000000021}
+
+	want := big.NewInt(1)
+	for _, p := range primes {
+		want.Mul(want, big.NewInt(p))
+	}
+
+	_, err := LCMOf(primes)
+
+	var overflowErr *OverflowError
+	if !errors.As(err, &overflowErr) || overflowErr.Exact.Cmp(want) != 0 {
+		t.Errorf("got %v, want an overflow of %s", err, want)
+	}
+}
+
+func TestCRT(t *testing.T) {
+	for _, test := range []struct {
+		r1, m1, r2, m2 int64
+		r, m           int64
+		ok             bool
+	}{
+		{r1: 2, m1: 3, r2: 3, m2: 5, r: 8, m: 15, ok: true},
+		{r1: 1, m1: 4, r2: 3, m2: 6, r: 9, m: 12, ok: true},
+		{r1: 0, m1: 4, r2: 1, m2: 6, ok: false},
+		{r1: 5, m1: 7, r2: 5, m2: 7, r: 5, m: 7, ok: true},
+	} {
+		r, m, ok, err := CRT(test.r1, test.m1, test.r2, test.m2)
+		if err != nil || ok != test.ok || ok && (r != test.r || m != test.m) {
+			t.Errorf("CRT(%d, %d, %d, %d): got %d, %d, %t, %v", test.r1, test.m1, test.r2, test.m2, r, m, ok, err)
+		}
+	}
+
+	_, _, _, err := CRT(1, math.MaxInt64, 2, math.MaxInt64-1)
+
+	var overflowErr *OverflowError
+	if !errors.As(err, &overflowErr) {
+		t.Errorf("got %v, want an overflow", err)
+	}
+}
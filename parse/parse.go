@@ -0,0 +1,217 @@
+// Package parse reports malformed puzzle input with the position of the problem.
+package parse
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Error is a problem in the input. Line and column start from 1, zero means the position is unknown.
+type Error struct {
+	File   string
+	Line   int
+	Column int
+	Err    error
+}
+
+func (e *Error) Error() string {
+	var b strings.Builder
+
+	b.WriteString(e.File)
+
+	for _, position := range []int{e.Line, e.Column} {
+		if position == 0 {
+			break
+		}
+
+		if b.Len() > 0 {
+			b.WriteByte(':')
+		}
+
+		b.WriteString(strconv.Itoa(position))
+	}
+
+	if b.Len() > 0 {
+		b.WriteString(": ")
+	}
+
+	b.WriteString(e.Err.Error())
+
+	return b.String()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func Errorf(line, column int, format string, args ...any) *Error {
+	return &Error{
+		Line:   line,
+		Column: column,
+		Err:    fmt.Errorf(format, args...),
+	}
+}
+
+// Scanner is a bufio.Scanner counting lines.
+type Scanner struct {
+	*bufio.Scanner
+	line int
+}
+
+func NewScanner(r io.Reader) *Scanner {
+	return &Scanner{Scanner: bufio.NewScanner(r)}
+}
+
+func (s *Scanner) Scan() bool {
+	if !s.Scanner.Scan() {
+		return false
+	}
+
+	s.line++
+
+	return true
+}
+
+// Line returns the number of the last scanned line.
+func (s *Scanner) Line() int {
+	return s.line
+}
+
+// Field returns the last scanned line.
+func (s *Scanner) Field() Field {
+	return Field{
+		Text:   s.Text(),
+		Line:   s.line,
+		Column: 1,
+	}
+}
+
+// Errorf reports an error at the column of the last scanned line.
+func (s *Scanner) Errorf(column int, format string, args ...any) *Error {
+	return Errorf(s.line, column, format, args...)
+}
+
+// Field is a piece of an input line which remembers where it starts.
+type Field struct {
+	Text   string
+	Line   int
+	Column int
+}
+
+// Errorf reports an error at the start of the field.
+func (f Field) Errorf(format string, args ...any) *Error {
+	return Errorf(f.Line, f.Column, format, args...)
+}
+
+// Slice returns the field's bytes from i to j, like f.Text[i:j], reporting an error if the field is too short.
+func (f Field) Slice(i, j int) (Field, error) {
+	if j > len(f.Text) || i > j {
+		return Field{}, f.Errorf("expected at least %d characters, got %q", j, f.Text)
+	}
+
+	return f.sub(i, j), nil
+}
+
+// TrimPrefix removes the prefix, reporting an error if the field doesn't start with it.
+func (f Field) TrimPrefix(prefix string) (Field, error) {
+	if !strings.HasPrefix(f.Text, prefix) {
+		return Field{}, f.Errorf("expected %q, got %q", prefix, f.Text)
+	}
+
+	return f.sub(len(prefix), len(f.Text)), nil
+}
+
+// TrimSuffix removes the suffix, reporting an error if the field doesn't end with it.
+func (f Field) TrimSuffix(suffix string) (Field, error) {
+	if !strings.HasSuffix(f.Text, suffix) {
+		return Field{}, f.Errorf("expected %q at the end of %q", suffix, f.Text)
+	}
+
+	return f.sub(0, len(f.Text)-len(suffix)), nil
+}
+
+// Cut slices the field around the first separator, reporting an error if there is none.
+func (f Field) Cut(sep string) (before, after Field, err error) {
+	i := strings.Index(f.Text, sep)
+	if i == -1 {
+		return Field{}, Field{}, f.Errorf("expected %q in %q", sep, f.Text)
+	}
+
+	return f.sub(0, i), f.sub(i+len(sep), len(f.Text)), nil
+}
+
+// Split slices the field into all pieces separated by sep, like strings.Split.
+func (f Field) Split(sep string) []Field {
+	var res []Field
+
+	for start := 0; ; {
+		i := strings.Index(f.Text[start:], sep)
+		if i == -1 {
+			return append(res, f.sub(start, len(f.Text)))
+		}
+
+		res = append(res, f.sub(start, start+i))
+		start += i + len(sep)
+	}
+}
+
+// Fields splits the field around runs of spaces, like strings.Fields.
+func (f Field) Fields() []Field {
+	var res []Field
+
+	start := -1
+	for i := 0; i <= len(f.Text); i++ {
+		if i < len(f.Text) && f.Text[i] != ' ' && f.Text[i] != '\t' {
+			if start == -1 {
+				start = i
+			}
+
+			continue
+		}
+
+		if start != -1 {
+			res = append(res, f.sub(start, i))
+			start = -1
+		}
+	}
+
+	return res
+}
+
+// Int parses the field as a decimal number.
+func (f Field) Int() (int, error) {
+	res, err := strconv.Atoi(f.Text)
+	if err != nil {
+		return 0, f.Errorf("expected a number, got %q", f.Text)
+	}
+
+	return res, nil
+}
+
+// Ints parses space-separated decimal numbers.
+func (f Field) Ints() ([]int, error) {
+	fields := f.Fields()
+
+	res := make([]int, len(fields))
+	for i, field := range fields {
+		number, err := field.Int()
+		if err != nil {
+			return nil, err
+		}
+
+		res[i] = number
+	}
+
+	return res, nil
+}
+
+func (f Field) sub(i, j int) Field {
+	return Field{
+		Text:   f.Text[i:j],
+		Line:   f.Line,
+		Column: f.Column + i,
+	}
+}
@@ -0,0 +1,101 @@
+package parse
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestErrorMessage(t *testing.T) {
+	cases := []struct {
+		err  *Error
+		want string
+	}{
+		{err: &Error{File: "input.txt", Line: 3, Column: 7, Err: errors.New("bad")}, want: "input.txt:3:7: bad"},
+		{err: &Error{File: "input.txt", Line: 3, Err: errors.New("bad")}, want: "input.txt:3: bad"},
+		{err: &Error{File: "input.txt", Err: errors.New("bad")}, want: "input.txt: bad"},
+		{err: &Error{Line: 3, Column: 7, Err: errors.New("bad")}, want: "3:7: bad"},
+		{err: &Error{Err: errors.New("bad")}, want: "bad"},
+	}
+
+	for _, c := range cases {
+		if got := c.err.Error(); got != c.want {
+			t.Errorf("got %q, want %q", got, c.want)
+		}
+	}
+}
+
+func TestErrorUnwrap(t *testing.T) {
+	err := fmt.Errorf("parse: %w", Errorf(1, 2, "wrapped: %w", errInner))
+
+	if !errors.Is(err, errInner) {
+		t.Errorf("%v doesn't wrap the inner error", err)
+	}
+
+	var parseErr *Error
+	if !errors.As(err, &parseErr) || parseErr.Line != 1 || parseErr.Column != 2 {
+		t.Errorf("%v doesn't wrap the parse error at 1:2", err)
+	}
+}
+
+var errInner = errors.New("inner")
+
+func TestScannerCountsLines(t *testing.T) {
+	scanner := NewScanner(strings.NewReader("a\n\nb c\n"))
+
+	var got []string
+	for scanner.Scan() {
+		for _, field := range scanner.Field().Fields() {
+			got = append(got, fmt.Sprintf("%d:%d %s", field.Line, field.Column, field.Text))
+		}
+	}
+
+	want := []string{"1:1 a", "3:1 b", "3:3 c"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestField(t *testing.T) {
+	line := Field{Text: "Card  1: 41 48 | 83  86", Line: 2, Column: 1}
+
+	card, numbers, err := line.Cut(": ")
+	if err != nil {
+		t.Fatalf("cut: %v", err)
+	}
+
+	id, err := card.TrimPrefix("Card")
+	if err != nil {
+		t.Fatalf("trim prefix: %v", err)
+	}
+
+	if got := id.Fields(); len(got) != 1 || got[0].Text != "1" || got[0].Column != 7 {
+		t.Errorf("got id fields %+v, want \"1\" at column 7", got)
+	}
+
+	parts := numbers.Split(" | ")
+	if len(parts) != 2 || parts[1].Column != 18 {
+		t.Fatalf("got parts %+v, want the second one at column 18", parts)
+	}
+
+	got, err := parts[1].Ints()
+	if err != nil {
+		t.Fatalf("ints: %v", err)
+	}
+
+	if fmt.Sprint(got) != "[83 86]" {
+		t.Errorf("got %v, want [83 86]", got)
+	}
+
+	if _, _, err := line.Cut(" @ "); err == nil {
+		t.Errorf("cut by a missing separator succeeded")
+	}
+
+	_, err = Field{Text: "12 x4", Line: 5, Column: 3}.Ints()
+
+	var parseErr *Error
+	if !errors.As(err, &parseErr) || parseErr.Line != 5 || parseErr.Column != 6 {
+		t.Errorf("got error %v, want it at 5:6", err)
+	}
+}
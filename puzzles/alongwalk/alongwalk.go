@@ -0,0 +1,410 @@
+// Package alongwalk solves day 23 of Advent of Code 2023, "A Long Walk".
+package alongwalk
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/harmlessevil/advent-of-code-2023/grid"
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+)
+
+type HikingTrailMap struct {
+	Size   grid.Point
+	Map    map[grid.Point][]grid.Point
+	Slopes map[grid.Point]grid.Direction // downhill direction of every slope
+}
+
+var slopeToDirection = map[rune]grid.Direction{
+	'^': grid.DirectionUp,
+	'>': grid.DirectionRight,
+	'v': grid.DirectionDown,
+	'<': grid.DirectionLeft,
+}
+
+func (m HikingTrailMap) Start() grid.Point {
+	return grid.Point{X: 1}
+}
+
+func (m HikingTrailMap) End() grid.Point {
+	return grid.Point{
+		X: m.Size.X - 2,
+		Y: m.Size.Y - 1,
+	}
+}
+
+// DownhillNeighbors returns points reachable from the point in one step, if slopes can be walked only downhill.
+func (m HikingTrailMap) DownhillNeighbors(point grid.Point) []grid.Point {
+	if slope, ok := m.Slopes[point]; ok {
+		return []grid.Point{point.Add(slope.ToVector())}
+	}
+
+	return m.Map[point]
+}
+
+func Part1(input io.Reader) (int, error) {
+	trailMap, err := ParseHikingTrailMap(input)
+	if err != nil {
+		return 0, fmt.Errorf("parse hiking trail map: %w", err)
+	}
+
+	length := findLongestDownhillPath(trailMap)
+	if length == -1 {
+		return 0, fmt.Errorf("no downhill hike from start to end")
+	}
+
+	return length, nil
+}
+
+func Part2(input io.Reader) (int, error) {
+	trailMap, err := ParseHikingTrailMap(input)
+	if err != nil {
+		return 0, fmt.Errorf("parse hiking trail map: %w", err)
+	}
+
+	// Crossroads are told apart from trails by the number of neighbors, which works only for trails one tile wide.
+	if point, ok := findWideTrail(trailMap); ok {
+		return 0, parse.Errorf(point.Y+1, point.X+1, "trail is wider than one tile")
+	}
+
+	startPoint := trailMap.Start()
+	nodeToColor := colorGraph(trailMap.Map, startPoint)
+	graph := condenseGraph(nodeToColor)
+
+	if len(nodeToColor[startPoint]) == 0 {
+		return 0, fmt.Errorf("start point's id not found")
+	}
+
+	var start int
+	for scc := range nodeToColor[startPoint] {
+		start = scc
+		break
+	}
+
+	endPoint := trailMap.End()
+
+	if len(nodeToColor[endPoint]) == 0 {
+		return 0, fmt.Errorf("end point's id not found")
+	}
+
+	var end int
+	for scc := range nodeToColor[endPoint] {
+		end = scc
+		break
+	}
+
+	path := findLongestPath(graph, Crossroad{
+		ID:    start,
+		Point: startPoint,
+	}, end)
+
+	return path.Length - 1, nil
+}
+
+// findWideTrail returns the top left corner of a 2x2 square without forest, if there is one.
+func findWideTrail(trailMap HikingTrailMap) (grid.Point, bool) {
+	isTrail := func(point grid.Point) bool {
+		_, ok := trailMap.Map[point]
+		return ok
+	}
+
+	for y := 0; y < trailMap.Size.Y-1; y++ {
+		for x := 0; x < trailMap.Size.X-1; x++ {
+			point := grid.Point{X: x, Y: y}
+			if isTrail(point) && isTrail(point.Add(grid.Point{X: 1})) && isTrail(point.Add(grid.Point{Y: 1})) &&
+				isTrail(point.Add(grid.Point{X: 1, Y: 1})) {
+				return point, true
+			}
+		}
+	}
+
+	return grid.Point{}, false
+}
+
+func ParseHikingTrailMap(r io.Reader) (HikingTrailMap, error) {
+	tiles, err := grid.Parse(r, func(_ grid.Point, symbol rune) (rune, error) {
+		if _, ok := slopeToDirection[symbol]; !ok && symbol != '.' && symbol != '#' {
+			return 0, fmt.Errorf("unknown tile")
+		}
+
+		return symbol, nil
+	})
+	if err != nil {
+		return HikingTrailMap{}, err
+	}
+
+	if tiles.Width < 3 || tiles.Height < 2 {
+		return HikingTrailMap{}, fmt.Errorf("map is %dx%d, too small for a hike", tiles.Width, tiles.Height)
+	}
+
+	trailMap := HikingTrailMap{
+		Size:   grid.Point{X: tiles.Width, Y: tiles.Height},
+		Map:    map[grid.Point][]grid.Point{},
+		Slopes: map[grid.Point]grid.Direction{},
+	}
+
+	for _, point := range tiles.Points() {
+		symbol := tiles.At(point)
+		if symbol == '#' {
+			continue
+		}
+
+		if slope, ok := slopeToDirection[symbol]; ok {
+			trailMap.Slopes[point] = slope
+		}
+
+		for _, neighbor := range tiles.Neighbors4(point) {
+			if tiles.At(neighbor) != '#' {
+				trailMap.Map[point] = append(trailMap.Map[point], neighbor)
+			}
+		}
+	}
+
+	for _, point := range []grid.Point{trailMap.Start(), trailMap.End()} {
+		if tiles.At(point) != '.' {
+			return HikingTrailMap{}, parse.Errorf(point.Y+1, point.X+1, "expected a path at the edge of the map, got %q", tiles.At(point))
+		}
+	}
+
+	return trailMap, nil
+}
+
+// findLongestDownhillPath walks trails between crossroads and finds the longest hike over the resulting graph, which
+// never steps onto the same tile twice.
+func findLongestDownhillPath(trailMap HikingTrailMap) int {
+	start, end := trailMap.Start(), trailMap.End()
+
+	isCrossroad := func(point grid.Point) bool {
+		return point == start || point == end || len(trailMap.Map[point]) > 2
+	}
+
+	trails := map[grid.Point]map[grid.Point]int{}
+
+	for point := range trailMap.Map {
+		if !isCrossroad(point) {
+			continue
+		}
+
+		trails[point] = map[grid.Point]int{}
+
+	walkTrail:
+		for _, next := range trailMap.DownhillNeighbors(point) {
+			prev, length := point, 1
+
+			for !isCrossroad(next) {
+				neighbors := trailMap.DownhillNeighbors(next)
+
+				i := slices.IndexFunc(neighbors, func(neighbor grid.Point) bool {
+					return neighbor != prev
+				})
+				if i == -1 {
+					continue walkTrail // a dead end, or a slope leading back
+				}
+
+				prev, next = next, neighbors[i]
+				length++
+			}
+
+			trails[point][next] = max(trails[point][next], length)
+		}
+	}
+
+	visited := map[grid.Point]struct{}{start: {}}
+
+	var longest func(point grid.Point) int
+	longest = func(point grid.Point) int {
+		if point == end {
+			return 0
+		}
+
+		res := -1
+		for next, length := range trails[point] {
+			if _, ok := visited[next]; ok {
+				continue
+			}
+
+			visited[next] = struct{}{}
+			if rest := longest(next); rest != -1 {
+				res = max(res, rest+length)
+			}
+			delete(visited, next)
+		}
+
+		return res
+	}
+
+	return longest(start)
+}
+
+type Node struct {
+	ID     int
+	Edges  map[int]grid.Point
+	Weight int
+}
+
+func colorGraph(graph map[grid.Point][]grid.Point, start grid.Point) map[grid.Point]map[int]struct{} {
+	nodeToColor := make(map[grid.Point]map[int]struct{}, len(graph))
+
+	nextFreeColor := 0
+
+	var dfs func(point grid.Point, index int)
+	dfs = func(point grid.Point, index int) {
+		neighbors := graph[point]
+
+		for ; len(neighbors) <= 2; neighbors = graph[point] {
+			nodeToColor[point] = map[int]struct{}{index: {}}
+
+			var next *grid.Point
+			for _, neighbor := range neighbors {
+				if _, ok := nodeToColor[neighbor]; !ok {
+					n := neighbor
+					next = &n
+					break
+				}
+			}
+
+			if next == nil {
+				return
+			}
+
+			point = *next
+		}
+
+		if _, ok := nodeToColor[point]; !ok {
+			nodeToColor[point] = map[int]struct{}{}
+		}
+		nodeToColor[point][index] = struct{}{}
+
+		for _, next := range neighbors {
+			if _, ok := nodeToColor[next]; !ok {
+				nextFreeColor++
+				dfs(next, nextFreeColor)
+			}
+
+			for id := range nodeToColor[next] {
+				nodeToColor[point][id] = struct{}{}
+			}
+		}
+	}
+
+	dfs(start, nextFreeColor)
+
+	return nodeToColor
+}
+
+func setToSlice[E comparable](set map[E]struct{}) []E {
+	res := make([]E, 0, len(set))
+	for elem := range set {
+		res = append(res, elem)
+	}
+
+	return res
+}
+
+func condenseGraph(coloring map[grid.Point]map[int]struct{}) map[int]Node {
+	res := map[int]Node{}
+
+	for point, c := range coloring {
+		colors := setToSlice(c)
+		if len(colors) > 1 {
+			for i := range colors {
+				for j := i + 1; j < len(colors); j++ {
+					if _, ok := res[colors[i]]; !ok {
+						res[colors[i]] = Node{
+							ID:    colors[i],
+							Edges: map[int]grid.Point{},
+						}
+					}
+
+					node := res[colors[i]]
+					node.Edges[colors[j]] = point
+					res[colors[i]] = node
+
+					if _, ok := res[colors[j]]; !ok {
+						res[colors[j]] = Node{
+							ID:    colors[j],
+							Edges: map[int]grid.Point{},
+						}
+					}
+
+					node = res[colors[j]]
+					node.Edges[colors[i]] = point
+					res[colors[j]] = node
+				}
+			}
+		} else {
+			if _, ok := res[colors[0]]; !ok {
+				res[colors[0]] = Node{
+					ID:    colors[0],
+					Edges: map[int]grid.Point{},
+				}
+			}
+
+			node := res[colors[0]]
+			node.Weight++
+			res[colors[0]] = node
+		}
+	}
+
+	return res
+}
+
+type Path struct {
+	Nodes  []Node
+	Length int
+}
+
+func findLongestPath(graph map[int]Node, start Crossroad, end int) Path {
+	return dfs(graph, end, map[grid.Point]*Crossroad{}, start)
+}
+
+type Crossroad struct {
+	ID    int
+	Point grid.Point
+}
+
+func dfs(graph map[int]Node, target int, prev map[grid.Point]*Crossroad, current Crossroad) Path {
+	if current.ID == target {
+		res := Path{
+			Nodes:  make([]Node, 0, len(prev)),
+			Length: 0,
+		}
+
+		for cur := &current; cur != nil; cur = prev[cur.Point] {
+			node := graph[cur.ID]
+			node.ID = cur.ID
+
+			res.Nodes = append(res.Nodes, node)
+			res.Length += node.Weight + 1 // crossroad at the end
+		}
+
+		res.Length-- // extra crossroad at the end
+		return res
+	}
+
+	var res Path
+	for next, nextPoint := range graph[current.ID].Edges {
+		if _, ok := prev[nextPoint]; ok {
+			continue
+		}
+
+		prev[nextPoint] = &Crossroad{
+			ID:    current.ID,
+			Point: current.Point,
+		}
+
+		path := dfs(graph, target, prev, Crossroad{
+			ID:    next,
+			Point: nextPoint,
+		})
+
+		if path.Length > res.Length {
+			res = path
+		}
+
+		delete(prev, nextPoint)
+	}
+
+	return res
+}
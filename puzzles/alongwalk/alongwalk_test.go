@@ -0,0 +1,36 @@
+package alongwalk
+
+import (
+	"testing"
+
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/internal/puzzletest"
+)
+
+func TestExamples(t *testing.T) {
+	puzzletest.Run(t, []puzzletest.Case{
+		{
+			Name:  "part 1",
+			Solve: Part1,
+			Input: "testdata/example.txt",
+			Want:  94,
+		},
+		{
+			Name:  "part 2",
+			Solve: Part2,
+			Input: "testdata/example.txt",
+			Want:  154,
+		},
+	})
+}
+
+func BenchmarkParse(b *testing.B) {
+	puzzletest.Benchmark(b, 23, "testdata/example.txt", ParseHikingTrailMap)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	puzzletest.Benchmark(b, 23, "testdata/example.txt", Part1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	puzzletest.Benchmark(b, 23, "testdata/example.txt", Part2)
+}
@@ -0,0 +1,80 @@
+package puzzles
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/internal/puzzletest"
+)
+
+// answersPath points to the personal answers for real inputs. Puzzle inputs and answers shouldn't be shared, so the
+// file is git-ignored, and the test is skipped without it. It lists answers of every solved day:
+//
+//	[
+//	  {"day": 1, "input": "inputs/day01.txt", "answers": [54630, 54770]}
+//	]
+//
+// Input paths are relative to the file. A null answer skips the part.
+const answersPath = "../answers.json"
+
+type Answers struct {
+	Day     int     `json:"day"`
+	Input   string  `json:"input"`
+	Answers [2]*int `json:"answers"`
+}
+
+func TestAnswers(t *testing.T) {
+	data, err := os.ReadFile(answersPath)
+	if errors.Is(err, os.ErrNotExist) {
+		t.Skipf("%s not found", answersPath)
+	}
+	if err != nil {
+		t.Fatalf("read answers: %v", err)
+	}
+
+	var days []Answers
+	if err := json.Unmarshal(data, &days); err != nil {
+		t.Fatalf("parse answers: %v", err)
+	}
+
+	var cases []puzzletest.Case
+
+	for _, day := range days {
+		puzzle, err := Lookup(day.Day)
+		if err != nil {
+			t.Fatalf("lookup: %v", err)
+		}
+
+		for i, answer := range day.Answers {
+			if answer == nil {
+				continue
+			}
+
+			solver, err := puzzle.Part(i + 1)
+			if err != nil {
+				t.Fatalf("day %d: %v", day.Day, err)
+			}
+
+			cases = append(cases, puzzletest.Case{
+				Name:  fmt.Sprintf("day %d part %d", day.Day, i+1),
+				Solve: answerOf(solver),
+				Input: filepath.Join(filepath.Dir(answersPath), day.Input),
+				Want:  *answer,
+			})
+		}
+	}
+
+	puzzletest.Run(t, cases)
+}
+
+func answerOf(solver Solver) func(input io.Reader) (int, error) {
+	return func(input io.Reader) (int, error) {
+		result, err := solver.Solve(input)
+		return result.Answer, err
+	}
+}
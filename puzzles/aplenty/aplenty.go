@@ -0,0 +1,373 @@
+// Package aplenty solves day 19 of Advent of Code 2023, "Aplenty".
+package aplenty
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+)
+
+type WorkflowContext map[string]Workflow
+
+type Workflow struct {
+	Rules []Rule
+}
+
+type Range struct {
+	Min int
+	Max int
+}
+
+func (r Range) Intersect(other Range) Range {
+	return Range{
+		Min: max(r.Min, other.Min),
+		Max: min(r.Max, other.Max),
+	}
+}
+
+func (r Range) Subtract(other Range) Range {
+	if r.Min == other.Min {
+		return Range{
+			Min: other.Max,
+			Max: r.Max,
+		}
+	}
+
+	if r.Max == other.Max {
+		return Range{
+			Min: r.Min,
+			Max: other.Min,
+		}
+	}
+
+	panic(fmt.Errorf("not implemented in general case"))
+}
+
+type Condition struct {
+	Category string
+	Target   Range
+}
+
+type Rule struct {
+	Condition *Condition
+	Target    string
+}
+
+type PartPattern struct {
+	X Range
+	M Range
+	A Range
+	S Range
+}
+
+func (p PartPattern) IsEmpty() bool {
+	return p.X.Max <= p.X.Min || p.M.Max <= p.M.Min || p.A.Max <= p.A.Min || p.S.Max <= p.S.Min
+}
+
+var MaxRange = Range{
+	Min: 1,
+	Max: 4001,
+}
+
+type Part struct {
+	X int
+	M int
+	A int
+	S int
+}
+
+// Pattern matches only this part.
+func (p Part) Pattern() PartPattern {
+	return PartPattern{
+		X: Range{Min: p.X, Max: p.X + 1},
+		M: Range{Min: p.M, Max: p.M + 1},
+		A: Range{Min: p.A, Max: p.A + 1},
+		S: Range{Min: p.S, Max: p.S + 1},
+	}
+}
+
+func Part1(input io.Reader) (int, error) {
+	workflowContext, parts, err := ParseSystem(input)
+	if err != nil {
+		return 0, err
+	}
+
+	sum := 0
+
+	for _, part := range parts {
+		if countAcceptedParts(workflowContext, "in", part.Pattern()) == 1 {
+			sum += part.X + part.M + part.A + part.S
+		}
+	}
+
+	return sum, nil
+}
+
+func Part2(input io.Reader) (int, error) {
+	scanner := parse.NewScanner(input)
+
+	workflowContext, err := parseWorkflows(scanner)
+	if err != nil {
+		return 0, fmt.Errorf("parse workflow context: %w", err)
+	}
+
+	return countAcceptedParts(workflowContext, "in", PartPattern{
+		X: MaxRange,
+		M: MaxRange,
+		A: MaxRange,
+		S: MaxRange,
+	}), nil
+}
+
+// ParseSystem reads workflows and the parts to sort with them.
+func ParseSystem(r io.Reader) (WorkflowContext, []Part, error) {
+	scanner := parse.NewScanner(r)
+
+	workflowContext, err := parseWorkflows(scanner)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse workflow context: %w", err)
+	}
+
+	parts, err := parseParts(scanner)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse parts: %w", err)
+	}
+
+	return workflowContext, parts, nil
+}
+
+func parseWorkflows(scanner *parse.Scanner) (WorkflowContext, error) {
+	res := WorkflowContext{}
+
+	var targets []parse.Field // workflows sent to, to check that all of them exist
+
+	for scanner.Scan() {
+		line := scanner.Field()
+		if line.Text == "" {
+			break
+		}
+
+		name, rulesText, err := line.Cut("{")
+		if err != nil {
+			return nil, err
+		}
+
+		if name.Text == "" {
+			return nil, name.Errorf("expected workflow name")
+		}
+
+		if _, ok := res[name.Text]; ok {
+			return nil, name.Errorf("duplicate workflow %s", name.Text)
+		}
+
+		rulesText, err = rulesText.TrimSuffix("}")
+		if err != nil {
+			return nil, err
+		}
+
+		ruleFields := rulesText.Split(",")
+
+		rules := make([]Rule, len(ruleFields))
+		for i, ruleField := range ruleFields {
+			rule, target, err := parseRule(ruleField)
+			if err != nil {
+				return nil, err
+			}
+
+			if (rule.Condition == nil) != (i == len(ruleFields)-1) {
+				return nil, ruleField.Errorf("only the last rule must have no condition")
+			}
+
+			rules[i] = rule
+			targets = append(targets, target)
+		}
+
+		res[name.Text] = Workflow{Rules: rules}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan: %w", err)
+	}
+
+	if _, ok := res["in"]; !ok {
+		return nil, fmt.Errorf("no workflow \"in\"")
+	}
+
+	for _, target := range targets {
+		if _, ok := res[target.Text]; !ok && target.Text != "A" && target.Text != "R" {
+			return nil, target.Errorf("unknown workflow %s", target.Text)
+		}
+	}
+
+	return res, nil
+}
+
+// parseRule reads a rule like "a<2006:qkq" or just "rfg". It also returns the field of the target workflow.
+func parseRule(field parse.Field) (Rule, parse.Field, error) {
+	criteria, target, err := field.Cut(":")
+	if err != nil {
+		if field.Text == "" {
+			return Rule{}, parse.Field{}, field.Errorf("empty rule")
+		}
+
+		return Rule{Target: field.Text}, field, nil
+	}
+
+	if target.Text == "" {
+		return Rule{}, parse.Field{}, target.Errorf("expected target workflow")
+	}
+
+	if len(criteria.Text) < 3 {
+		return Rule{}, parse.Field{}, criteria.Errorf("expected a condition like \"a<2006\", got %q", criteria.Text)
+	}
+
+	category, _ := criteria.Slice(0, 1)
+	if !strings.Contains("xmas", category.Text) {
+		return Rule{}, parse.Field{}, category.Errorf("unknown category %q", category.Text)
+	}
+
+	operation, _ := criteria.Slice(1, 2)
+	valueText, _ := criteria.Slice(2, len(criteria.Text))
+
+	value, err := valueText.Int()
+	if err != nil {
+		return Rule{}, parse.Field{}, err
+	}
+
+	condition := MaxRange
+	switch operation.Text {
+	case "<":
+		condition.Max = value
+	case ">":
+		condition.Min = value + 1
+	default:
+		return Rule{}, parse.Field{}, operation.Errorf("unknown operation %q", operation.Text)
+	}
+
+	return Rule{
+		Target: target.Text,
+		Condition: &Condition{
+			Category: category.Text,
+			Target:   condition,
+		},
+	}, target, nil
+}
+
+func parseParts(scanner *parse.Scanner) ([]Part, error) {
+	var parts []Part
+
+	for scanner.Scan() {
+		part, err := parsePart(scanner.Field())
+		if err != nil {
+			return nil, err
+		}
+
+		parts = append(parts, part)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan: %w", err)
+	}
+
+	return parts, nil
+}
+
+// parsePart reads a part like "{x=787,m=2655,a=1222,s=2876}", with categories in this order and ratings in MaxRange.
+func parsePart(line parse.Field) (Part, error) {
+	ratingsText, err := line.TrimPrefix("{")
+	if err != nil {
+		return Part{}, err
+	}
+
+	ratingsText, err = ratingsText.TrimSuffix("}")
+	if err != nil {
+		return Part{}, err
+	}
+
+	ratingFields := ratingsText.Split(",")
+	if len(ratingFields) != 4 {
+		return Part{}, line.Errorf("expected 4 ratings, got %q", line.Text)
+	}
+
+	var part Part
+	ratings := [4]*int{&part.X, &part.M, &part.A, &part.S}
+
+	for i, ratingField := range ratingFields {
+		valueText, err := ratingField.TrimPrefix("xmas"[i:i+1] + "=")
+		if err != nil {
+			return Part{}, err
+		}
+
+		if *ratings[i], err = valueText.Int(); err != nil {
+			return Part{}, err
+		}
+
+		// Workflows are solved for ratings in MaxRange, and would misjudge parts outside of it.
+		if *ratings[i] < MaxRange.Min || *ratings[i] >= MaxRange.Max {
+			return Part{}, valueText.Errorf("rating %d is out of range %d-%d", *ratings[i], MaxRange.Min,
+				MaxRange.Max-1)
+		}
+	}
+
+	return part, nil
+}
+
+func countAcceptedParts(c WorkflowContext, workflowName string, p PartPattern) int {
+	if workflowName == "R" || p.IsEmpty() {
+		return 0
+	}
+
+	if workflowName == "A" {
+		return (p.X.Max - p.X.Min) * (p.M.Max - p.M.Min) * (p.A.Max - p.A.Min) * (p.S.Max - p.S.Min)
+	}
+
+	res := 0
+
+	for _, rule := range c[workflowName].Rules {
+		next := p
+
+		if rule.Condition != nil {
+			switch rule.Condition.Category {
+			case "x":
+				next.X = p.X.Intersect(rule.Condition.Target)
+				if next.X.Max <= next.X.Min {
+					continue
+				}
+			case "m":
+				next.M = p.M.Intersect(rule.Condition.Target)
+				if next.M.Max <= next.M.Min {
+					continue
+				}
+			case "a":
+				next.A = p.A.Intersect(rule.Condition.Target)
+				if next.A.Max <= next.A.Min {
+					continue
+				}
+			case "s":
+				next.S = p.S.Intersect(rule.Condition.Target)
+				if next.S.Max <= next.S.Min {
+					continue
+				}
+			}
+		}
+
+		res += countAcceptedParts(c, rule.Target, next)
+
+		if rule.Condition != nil {
+			switch rule.Condition.Category {
+			case "x":
+				p.X = p.X.Intersect(MaxRange.Subtract(rule.Condition.Target))
+			case "m":
+				p.M = p.M.Intersect(MaxRange.Subtract(rule.Condition.Target))
+			case "a":
+				p.A = p.A.Intersect(MaxRange.Subtract(rule.Condition.Target))
+			case "s":
+				p.S = p.S.Intersect(MaxRange.Subtract(rule.Condition.Target))
+			}
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,54 @@
+package aplenty
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/internal/puzzletest"
+)
+
+func TestExamples(t *testing.T) {
+	puzzletest.Run(t, []puzzletest.Case{
+		{
+			Name:  "part 1",
+			Solve: Part1,
+			Input: "testdata/example.txt",
+			Want:  19114,
+		},
+		{
+			Name:  "part 2",
+			Solve: Part2,
+			Input: "testdata/example.txt",
+			Want:  167409079868000,
+		},
+	})
+}
+
+func BenchmarkParse(b *testing.B) {
+	puzzletest.Benchmark(b, 19, "testdata/example.txt", func(input io.Reader) (WorkflowContext, error) {
+		workflows, _, err := ParseSystem(input)
+		return workflows, err
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	puzzletest.Benchmark(b, 19, "testdata/example.txt", Part1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	puzzletest.Benchmark(b, 19, "testdata/example.txt", Part2)
+}
+
+func TestRatingOutOfRange(t *testing.T) {
+	for _, part := range []string{"{x=5000,m=1,a=1,s=1}", "{x=1,m=1,a=0,s=1}"} {
+		_, err := Part1(strings.NewReader("in{x>3000:A,R}\n\n" + part + "\n"))
+
+		var parseErr *parse.Error
+		if !errors.As(err, &parseErr) || parseErr.Line != 3 {
+			t.Errorf("%s: got %v, want an error on line 3", part, err)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+// Package camelcards solves day 7 of Advent of Code 2023, "Camel Cards".
+package camelcards
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+)
+
+type Hand struct {
+	Line     int // of the input, from 1
+	Cards    string
+	Bid      int
+	Category int   // index in the categories of the rules, from the weakest
+	Strength []int // places of the cards in the order of the rules, for tie-breaks
+}
+
+func Part1(input io.Reader) (int, error) {
+	return Winnings(input, Standard)
+}
+
+func Part2(input io.Reader) (int, error) {
+	return Winnings(input, Jokers)
+}
+
+// Winnings adds up bids of hands multiplied by their ranks.
+func Winnings(input io.Reader, rules Rules) (int, error) {
+	hands, err := ParseHands(input, rules)
+	if err != nil {
+		return 0, err
+	}
+
+	SortHands(hands)
+
+	winnings := 0
+	for i, hand := range hands {
+		winnings += hand.Bid * (i + 1)
+	}
+
+	return winnings, nil
+}
+
+// SortHands sorts hands from the weakest.
+func SortHands(hands []Hand) {
+	slices.SortStableFunc(hands, compareHands)
+}
+
+func compareHands(a, b Hand) int {
+	if n := cmp.Compare(a.Category, b.Category); n != 0 {
+		return n
+	}
+
+	return slices.Compare(a.Strength, b.Strength)
+}
+
+func ParseHands(r io.Reader, rules Rules) ([]Hand, error) {
+	g, err := newGame(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.parseHands(r)
+}
+
+func (g *game) parseHands(r io.Reader) ([]Hand, error) {
+	var hands []Hand
+
+	scanner := parse.NewScanner(r)
+	for scanner.Scan() {
+		hand, err := g.parseHand(scanner.Field())
+		if err != nil {
+			return nil, fmt.Errorf("parse hand: %w", err)
+		}
+
+		hands = append(hands, hand)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan: %w", err)
+	}
+
+	return hands, nil
+}
+
+// game is rules prepared for ranking hands.
+type game struct {
+	Rules
+	places     map[rune]int // of cards in the order
+	categories []Category
+}
+
+func newGame(rules Rules) (*game, error) {
+	if err := rules.Validate(); err != nil {
+		return nil, fmt.Errorf("rules: %w", err)
+	}
+
+	g := &game{
+		Rules:      rules,
+		places:     make(map[rune]int, len(rules.Order)),
+		categories: rules.categories(),
+	}
+
+	for i, card := range []rune(rules.Order) {
+		g.places[card] = i
+	}
+
+	return g, nil
+}
+
+func (g *game) parseHand(line parse.Field) (Hand, error) {
+	cards, bidText, err := line.Cut(" ")
+	if err != nil {
+		return Hand{}, err
+	}
+
+	strength := make([]int, 0, g.HandSize)
+	for i, card := range cards.Text {
+		place, ok := g.places[card]
+		if !ok {
+			return Hand{}, parse.Errorf(cards.Line, cards.Column+i, "unknown card %q", card)
+		}
+
+		strength = append(strength, place)
+	}
+
+	if len(strength) != g.HandSize {
+		return Hand{}, cards.Errorf("expected %d cards, got %q", g.HandSize, cards.Text)
+	}
+
+	category := g.classify(cards.Text)
+	if category == -1 {
+		return Hand{}, cards.Errorf("hand %q fits no category", cards.Text)
+	}
+
+	bid, err := bidText.Int()
+	if err != nil {
+		return Hand{}, err
+	}
+
+	return Hand{
+		Line:     line.Line,
+		Cards:    cards.Text,
+		Bid:      bid,
+		Category: category,
+		Strength: strength,
+	}, nil
+}
@@ -0,0 +1,219 @@
+package camelcards
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/internal/puzzletest"
+)
+
+func TestExamples(t *testing.T) {
+	puzzletest.Run(t, []puzzletest.Case{
+		{
+			Name:  "part 1",
+			Solve: Part1,
+			Input: "testdata/example.txt",
+			Want:  6440,
+		},
+		{
+			Name:  "part 2",
+			Solve: Part2,
+			Input: "testdata/example.txt",
+			Want:  5905,
+		},
+	})
+}
+
+func BenchmarkParse(b *testing.B) {
+	puzzletest.Benchmark(b, 7, "testdata/example.txt", func(input io.Reader) ([]Hand, error) {
+		return ParseHands(input, Standard)
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	puzzletest.Benchmark(b, 7, "testdata/example.txt", Part1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	puzzletest.Benchmark(b, 7, "testdata/example.txt", Part2)
+}
+
+func TestCategories(t *testing.T) {
+	g, err := newGame(Jokers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, category := range g.categories {
+		names = append(names, category.Name)
+	}
+
+	want := "high card, one pair, two pair, three of a kind, full house, four of a kind, five of a kind"
+	if got := strings.Join(names, ", "); got != want {
+		t.Errorf("got categories %s, want %s", got, want)
+	}
+
+	for cards, want := range map[string]string{
+		"32T3K": "one pair",
+		"KTJJT": "four of a kind",
+		"JJJJJ": "five of a kind",
+		"2345J": "one pair",
+		"22JKK": "full house",
+		"2J3J4": "three of a kind",
+	} {
+		if got := g.categories[g.classify(cards)].Name; got != want {
+			t.Errorf("%s: got %s, want %s", cards, got, want)
+		}
+	}
+}
+
+// TestWildCardsAgainstBruteForce compares categories of hands with jokers to the best of all hands the jokers could
+// turn into.
+func TestWildCardsAgainstBruteForce(t *testing.T) {
+	jokers, err := newGame(Jokers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	standard, err := newGame(Standard)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rng := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 2000; i++ {
+		cards := make([]byte, 5)
+		for j := range cards {
+			cards[j] = "J2345QKA"[rng.Intn(8)]
+		}
+
+		best := -1
+		for _, replacement := range "23456789TQKA" {
+			best = max(best, standard.classify(strings.ReplaceAll(string(cards), "J", string(replacement))))
+		}
+
+		got := jokers.classify(string(cards))
+		if got != best {
+			t.Errorf("%s: got %s, want %s", cards, jokers.categories[got].Name, standard.categories[best].Name)
+		}
+
+		// Jokers act like cards making the category without any wild cards.
+		if as := jokers.assign(string(cards), got); standard.classify(as) != best {
+			t.Errorf("%s: jokers act like %s, which is not %s", cards, as, standard.categories[best].Name)
+		}
+	}
+}
+
+func TestHouseRules(t *testing.T) {
+	// Four cards, deuces wild, and only groups matter.
+	rules := Rules{
+		HandSize: 4,
+		Order:    "2AKQ",
+		Wild:     "2",
+		Categories: []Category{
+			{Name: "nothing", Counts: []int{1}},
+			{Name: "pair", Counts: []int{2}},
+			{Name: "two pair", Counts: []int{2, 2}},
+			{Name: "three", Counts: []int{3}},
+			{Name: "four", Counts: []int{4}},
+		},
+	}
+
+	hands, err := ParseHands(strings.NewReader("AKQA 1\nAAKK 10\n2KQA 100\n22KQ 1000\n"), rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	SortHands(hands)
+
+	var order []string
+	for _, hand := range hands {
+		order = append(order, hand.Cards)
+	}
+
+	if got := strings.Join(order, " "); got != "2KQA AKQA AAKK 22KQ" {
+		t.Errorf("got hands ordered %s", got)
+	}
+
+	// Without the category of two pairs, they count as a pair.
+	rules.Categories = slices.Delete(slices.Clone(rules.Categories), 2, 3)
+	hands, err = ParseHands(strings.NewReader("AAKK 10\n"), rules)
+	if err != nil || rules.Categories[hands[0].Category].Name != "pair" {
+		t.Errorf("got %+v, %v, want a pair", hands, err)
+	}
+
+	for _, broken := range []Rules{
+		{HandSize: 5, Order: "AKA"},
+		{HandSize: 5, Order: "AK", Wild: "J"},
+		{HandSize: 2, Order: "AK", Categories: []Category{{Name: "three", Counts: []int{3}}}},
+		{HandSize: 2, Order: "AK", Categories: []Category{{Name: "a", Counts: []int{1}}, {Name: "b", Counts: []int{1}}}},
+		{HandSize: 2, Order: "AK", Categories: []Category{{Name: "up", Counts: []int{1, 2}}}},
+	} {
+		if err := broken.Validate(); err == nil {
+			t.Errorf("got no error for rules %+v", broken)
+		}
+	}
+}
+
+func TestExplain(t *testing.T) {
+	input := "32T3K 765\nT55J5 684\nKK677 28\nKTJJT 220\nQQQJA 483\nJJJJJ 1\nJ2345 2\n"
+
+	standard, err := Explain(strings.NewReader(input), Standard)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jokers, err := Explain(strings.NewReader(input), Jokers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	winnings, err := Winnings(strings.NewReader(input), Jokers)
+	if err != nil || jokers.Winnings != winnings {
+		t.Errorf("got winnings %d, want %d, %v", jokers.Winnings, winnings, err)
+	}
+
+	var got []string
+	for _, hand := range jokers.Hands {
+		got = append(got, fmt.Sprintf("%d %s=%s %s", hand.Rank, hand.Cards, hand.As, hand.Category))
+	}
+
+	want := []string{
+		"1 J2345=52345 one pair",
+		"2 32T3K= one pair",
+		"3 KK677= two pair",
+		"4 T55J5=T5555 four of a kind",
+		"5 QQQJA=QQQQA four of a kind",
+		"6 KTJJT=KTTTT four of a kind",
+		"7 JJJJJ=AAAAA five of a kind",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("got hands\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	got = nil
+	for _, move := range Diff(standard, jokers) {
+		got = append(got, fmt.Sprintf("%s %d->%d %s->%s", move.Cards, move.FromRank, move.ToRank, move.FromCategory,
+			move.ToCategory))
+	}
+
+	want = []string{
+		"KK677 4->3 two pair->two pair",
+		"T55J5 5->4 three of a kind->four of a kind",
+		"QQQJA 6->5 three of a kind->four of a kind",
+		"KTJJT 3->6 two pair->four of a kind",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("got moves\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	if moves := Diff(jokers, jokers); len(moves) != 0 {
+		t.Errorf("got moves %+v between the same reports", moves)
+	}
+}
@@ -0,0 +1,158 @@
+package camelcards
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"text/tabwriter"
+)
+
+// Report lists every hand from the weakest, with how it was ranked.
+type Report struct {
+	Winnings int          `json:"winnings"`
+	Hands    []HandReport `json:"hands"`
+	Moves    []Move       `json:"moves,omitempty"` // from the rules of the previous part
+}
+
+func (r Report) Answer() int {
+	return r.Winnings
+}
+
+type HandReport struct {
+	Line     int    `json:"line"`
+	Cards    string `json:"cards"`
+	As       string `json:"as,omitempty"` // cards that wild ones act like, if there are any
+	Category string `json:"category"`
+	Bid      int    `json:"bid"`
+	Rank     int    `json:"rank"`
+	Winnings int    `json:"winnings"`
+}
+
+// Move of a hand between ranks, when ranked by other rules.
+type Move struct {
+	Line         int    `json:"line"`
+	Cards        string `json:"cards"`
+	FromCategory string `json:"from_category"`
+	ToCategory   string `json:"to_category"`
+	FromRank     int    `json:"from_rank"`
+	ToRank       int    `json:"to_rank"`
+}
+
+func Part1Report(input io.Reader) (Report, error) {
+	return Explain(input, Standard)
+}
+
+// Part2Report also tells how hands moved from the standard rules once jokers became wild, ranking hands twice, so
+// Part2 is what answers without diagnostics.
+func Part2Report(input io.Reader) (Report, error) {
+	data, err := io.ReadAll(input)
+	if err != nil {
+		return Report{}, fmt.Errorf("read input: %w", err)
+	}
+
+	standard, err := Explain(bytes.NewReader(data), Standard)
+	if err != nil {
+		return Report{}, err
+	}
+
+	report, err := Explain(bytes.NewReader(data), Jokers)
+	if err != nil {
+		return Report{}, err
+	}
+
+	report.Moves = Diff(standard, report)
+
+	return report, nil
+}
+
+// Explain ranks hands by the rules, like Winnings, and reports every one of them.
+func Explain(input io.Reader, rules Rules) (Report, error) {
+	g, err := newGame(rules)
+	if err != nil {
+		return Report{}, err
+	}
+
+	hands, err := g.parseHands(input)
+	if err != nil {
+		return Report{}, err
+	}
+
+	SortHands(hands)
+
+	report := Report{Hands: make([]HandReport, 0, len(hands))}
+	for i, hand := range hands {
+		explained := HandReport{
+			Line:     hand.Line,
+			Cards:    hand.Cards,
+			Category: g.categories[hand.Category].Name,
+			Bid:      hand.Bid,
+			Rank:     i + 1,
+			Winnings: hand.Bid * (i + 1),
+		}
+
+		if as := g.assign(hand.Cards, hand.Category); as != hand.Cards {
+			explained.As = as
+		}
+
+		report.Winnings += explained.Winnings
+		report.Hands = append(report.Hands, explained)
+	}
+
+	return report, nil
+}
+
+// Diff returns hands whose rank changed between reports of the same input, ordered by their new rank.
+func Diff(from, to Report) []Move {
+	before := make(map[int]HandReport, len(from.Hands))
+	for _, hand := range from.Hands {
+		before[hand.Line] = hand
+	}
+
+	var moves []Move
+	for _, hand := range to.Hands {
+		old, ok := before[hand.Line]
+		if !ok || old.Rank == hand.Rank {
+			continue
+		}
+
+		moves = append(moves, Move{
+			Line:         hand.Line,
+			Cards:        hand.Cards,
+			FromCategory: old.Category,
+			ToCategory:   hand.Category,
+			FromRank:     old.Rank,
+			ToRank:       hand.Rank,
+		})
+	}
+
+	slices.SortFunc(moves, func(a, b Move) int {
+		return cmp.Compare(a.ToRank, b.ToRank)
+	})
+
+	return moves
+}
+
+// Explain writes a table of the hands from the weakest, followed by the moves if there are any.
+func (r Report) Explain(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "rank\tcards\tas\tcategory\tbid\twinnings")
+	for _, hand := range r.Hands {
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%d\t%d\n", hand.Rank, hand.Cards, hand.As, hand.Category, hand.Bid,
+			hand.Winnings)
+	}
+
+	fmt.Fprintf(tw, "\t\t\t\t\t%d\n", r.Winnings)
+
+	if len(r.Moves) > 0 {
+		fmt.Fprintln(tw, "\nmoved\tcards\tfrom\tto")
+		for _, move := range r.Moves {
+			fmt.Fprintf(tw, "%d -> %d (%+d)\t%s\t%s\t%s\n", move.FromRank, move.ToRank, move.ToRank-move.FromRank,
+				move.Cards, move.FromCategory, move.ToCategory)
+		}
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,261 @@
+package camelcards
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Category of hands, by sizes of groups of equal cards.
+type Category struct {
+	Name   string `json:"name"`
+	Counts []int  `json:"counts"` // groups the hand must have, from the largest; other cards don't matter
+}
+
+// Rules of a game of Camel Cards.
+type Rules struct {
+	HandSize   int
+	Order      string     // cards from the weakest, for tie-breaks
+	Wild       string     // cards acting like whatever card makes the strongest hand
+	Categories []Category // from the weakest; if empty, all ways to group cards, ordered like in poker
+}
+
+var (
+	// Standard rules of part 1, J is a jack.
+	Standard = Rules{HandSize: 5, Order: "23456789TJQKA"}
+	// Jokers are rules of part 2, J is a joker and the weakest card.
+	Jokers = Rules{HandSize: 5, Order: "J23456789TQKA", Wild: "J"}
+)
+
+// fiveCardNames are usual names of categories of five cards.
+var fiveCardNames = map[string]string{
+	"[5]":         "five of a kind",
+	"[4 1]":       "four of a kind",
+	"[3 2]":       "full house",
+	"[3 1 1]":     "three of a kind",
+	"[2 2 1]":     "two pair",
+	"[2 1 1 1]":   "one pair",
+	"[1 1 1 1 1]": "high card",
+}
+
+func (r Rules) Validate() error {
+	if r.HandSize < 1 {
+		return fmt.Errorf("invalid hand size %d", r.HandSize)
+	}
+
+	if r.Order == "" {
+		return errors.New("no cards")
+	}
+
+	for i, card := range r.Order {
+		if strings.ContainsRune(r.Order[i+len(string(card)):], card) {
+			return fmt.Errorf("card %q is repeated in the order", card)
+		}
+	}
+
+	for _, card := range r.Wild {
+		if !strings.ContainsRune(r.Order, card) {
+			return fmt.Errorf("wild card %q is not in the order", card)
+		}
+	}
+
+	seen := make(map[string]bool, len(r.Categories))
+	for _, category := range r.Categories {
+		sum := 0
+		for i, count := range category.Counts {
+			if count < 1 || i > 0 && count > category.Counts[i-1] {
+				return fmt.Errorf("category %q: counts %v must be positive and start from the largest", category.Name,
+					category.Counts)
+			}
+
+			sum += count
+		}
+
+		if sum == 0 || sum > r.HandSize {
+			return fmt.Errorf("category %q: counts %v don't fit in a hand of %d", category.Name, category.Counts,
+				r.HandSize)
+		}
+
+		key := fmt.Sprint(category.Counts)
+		if seen[key] {
+			return fmt.Errorf("category %q: counts %v are repeated", category.Name, category.Counts)
+		}
+
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// categories returns categories of the rules, or all of them ordered like in poker: by the largest group, then by the
+// next one, and so on.
+func (r Rules) categories() []Category {
+	if len(r.Categories) > 0 {
+		return r.Categories
+	}
+
+	var categories []Category
+	for _, counts := range partitions(r.HandSize, r.HandSize) {
+		categories = append(categories, Category{
+			Name:   categoryName(counts),
+			Counts: counts,
+		})
+	}
+
+	slices.Reverse(categories)
+
+	return categories
+}
+
+// partitions returns all ways to split n into parts of at most limit. Parts go from the largest, and partitions are
+// in descending lexicographic order.
+func partitions(n, limit int) [][]int {
+	if n == 0 {
+		return [][]int{nil}
+	}
+
+	var res [][]int
+	for first := min(n, limit); first > 0; first-- {
+		for _, rest := range partitions(n-first, first) {
+			res = append(res, append([]int{first}, rest...))
+		}
+	}
+
+	return res
+}
+
+func categoryName(counts []int) string {
+	if name, ok := fiveCardNames[fmt.Sprint(counts)]; ok {
+		return name
+	}
+
+	parts := make([]string, len(counts))
+	for i, count := range counts {
+		parts[i] = strconv.Itoa(count)
+	}
+
+	return strings.Join(parts, "+")
+}
+
+// group of equal cards.
+type group struct {
+	card  rune
+	count int
+}
+
+// groups counts equal cards other than wild ones, from the largest group and then the strongest card, and wild cards.
+func (g *game) groups(cards string) (groups []group, wilds int) {
+	byCard := make(map[rune]int, len(cards))
+	for _, card := range cards {
+		if strings.ContainsRune(g.Wild, card) {
+			wilds++
+		} else {
+			byCard[card]++
+		}
+	}
+
+	for card, count := range byCard {
+		groups = append(groups, group{card: card, count: count})
+	}
+
+	slices.SortFunc(groups, func(a, b group) int {
+		if a.count != b.count {
+			return b.count - a.count
+		}
+
+		return g.places[b.card] - g.places[a.card]
+	})
+
+	return groups, wilds
+}
+
+// classify returns the index of the strongest category the cards fit, or -1 if there is none.
+func (g *game) classify(cards string) int {
+	groups, wilds := g.groups(cards)
+
+	for i := len(g.categories) - 1; i >= 0; i-- {
+		if fits(groups, wilds, g.categories[i].Counts) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// fits reports whether groups of equal cards can be grown with wild cards to have the counts of the category, wild
+// cards making new groups if needed. Both are sorted from the largest, so it's best to grow every group to the count
+// at the same place.
+func fits(groups []group, wilds int, counts []int) bool {
+	for i, count := range counts {
+		size := 0
+		if i < len(groups) {
+			size = groups[i].count
+		}
+
+		wilds -= max(count-size, 0)
+	}
+
+	return wilds >= 0
+}
+
+// assign returns the cards with wild ones replaced by cards they act like in the category. Wild cards grow groups the
+// way fits does, making new groups of the strongest cards missing from the hand, and the rest join the largest group.
+// A wild card stays as it is if there is no card left for a new group.
+func (g *game) assign(cards string, category int) string {
+	groups, wilds := g.groups(cards)
+	if wilds == 0 {
+		return cards
+	}
+
+	var fill []rune
+	for i, count := range g.categories[category].Counts {
+		card, size := rune(0), 0
+		if i < len(groups) {
+			card, size = groups[i].card, groups[i].count
+		} else {
+			card = g.missing(cards, fill)
+		}
+
+		for ; size < count; size++ {
+			fill = append(fill, card)
+		}
+	}
+
+	var largest rune
+	if len(groups) > 0 {
+		largest = groups[0].card
+	} else {
+		largest = fill[0]
+	}
+
+	assigned := []rune(cards)
+	for i, card := range assigned {
+		if !strings.ContainsRune(g.Wild, card) {
+			continue
+		}
+
+		if len(fill) > 0 {
+			assigned[i], fill = fill[0], fill[1:]
+		} else {
+			assigned[i] = largest
+		}
+	}
+
+	return string(assigned)
+}
+
+// missing returns the strongest card other than wild ones that is in neither the hand nor used, or the first wild
+// card if there is none.
+func (g *game) missing(cards string, used []rune) rune {
+	order := []rune(g.Order)
+	for i := len(order) - 1; i >= 0; i-- {
+		card := order[i]
+		if !strings.ContainsRune(g.Wild, card) && !strings.ContainsRune(cards, card) && !slices.Contains(used, card) {
+			return card
+		}
+	}
+
+	return []rune(g.Wild)[0]
+}
@@ -0,0 +1,252 @@
+// Package clumsycrucible solves day 17 of Advent of Code 2023, "Clumsy Crucible".
+package clumsycrucible
+
+import (
+	"container/heap"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"slices"
+
+	"github.com/harmlessevil/advent-of-code-2023/grid"
+)
+
+type CityMap = grid.Grid[int]
+
+// Crucible limits how many blocks in a row it can move in a single direction.
+type Crucible struct {
+	MinStraight int
+	MaxStraight int
+}
+
+var (
+	Regular = Crucible{MinStraight: 1, MaxStraight: 3}
+	Ultra   = Crucible{MinStraight: 4, MaxStraight: 10}
+)
+
+// Report is the least heat loss and the path leading to it. The path starts from the block after the top left one.
+type Report struct {
+	HeatLoss int          `json:"heat_loss"`
+	Path     []grid.Point `json:"path"`
+}
+
+func (r Report) Answer() int {
+	return r.HeatLoss
+}
+
+func Part1(input io.Reader) (int, error) {
+	end, err := solve(input, Regular)
+	return end.Distance, err
+}
+
+func Part2(input io.Reader) (int, error) {
+	end, err := solve(input, Ultra)
+	return end.Distance, err
+}
+
+func Part1Report(input io.Reader) (Report, error) {
+	return solveReport(input, Regular)
+}
+
+func Part2Report(input io.Reader) (Report, error) {
+	return solveReport(input, Ultra)
+}
+
+func solveReport(input io.Reader, crucible Crucible) (Report, error) {
+	end, err := solve(input, crucible)
+	if err != nil {
+		return Report{}, err
+	}
+
+	var path []grid.Point
+	for state := end; state.Previous != nil; state = *state.Previous {
+		path = append(path, state.Point.Coordinate)
+	}
+	slices.Reverse(path)
+
+	return Report{
+		HeatLoss: end.Distance,
+		Path:     path,
+	}, nil
+}
+
+// solve returns the state at the factory with the least heat loss, leading back to the start.
+func solve(input io.Reader, crucible Crucible) (DijkstraState, error) {
+	cityMap, err := ParseCityMap(input)
+	if err != nil {
+		return DijkstraState{}, fmt.Errorf("parse city map: %w", err)
+	}
+
+	state := dijkstra(cityMap, crucible, DijkstraPoint{})
+
+	minState := DijkstraState{Distance: math.MaxInt}
+	for _, direction := range []grid.Direction{grid.DirectionRight, grid.DirectionDown} {
+		for count := crucible.MinStraight; count <= crucible.MaxStraight; count++ {
+			if item, ok := state[DijkstraPoint{
+				Count:     count,
+				Direction: direction,
+				Coordinate: grid.Point{
+					X: cityMap.Width - 1,
+					Y: cityMap.Height - 1,
+				},
+			}]; ok {
+				if item.Distance < minState.Distance {
+					minState = item
+				}
+			}
+		}
+	}
+
+	if minState.Distance == math.MaxInt {
+		return DijkstraState{}, fmt.Errorf("no path to the factory")
+	}
+
+	slog.Debug("found path", slog.Any("path", pathValue{cityMap: cityMap, end: minState}))
+
+	return minState, nil
+}
+
+// pathValue logs the path drawn on the map, drawing it only if debug logs are enabled.
+type pathValue struct {
+	cityMap CityMap
+	end     DijkstraState
+}
+
+func (v pathValue) LogValue() slog.Value {
+	return slog.StringValue("\n" + formatPath(v.cityMap, v.end))
+}
+
+// formatPath draws the path on the map, marking every block the crucible enters with its direction.
+func formatPath(cityMap CityMap, end DijkstraState) string {
+	solution := grid.New[rune](cityMap.Width, cityMap.Height)
+	for _, point := range cityMap.Points() {
+		solution.Set(point, rune('0'+cityMap.At(point)))
+	}
+
+	for point := end; point.Previous != nil; point = *point.Previous {
+		var direction rune
+		switch point.Point.Direction {
+		case grid.DirectionRight:
+			direction = '>'
+		case grid.DirectionDown:
+			direction = 'v'
+		case grid.DirectionLeft:
+			direction = '<'
+		case grid.DirectionUp:
+			direction = '^'
+		}
+
+		solution.Set(point.Point.Coordinate, direction)
+	}
+
+	return solution.String()
+}
+
+func ParseCityMap(r io.Reader) (CityMap, error) {
+	return grid.Parse(r, func(_ grid.Point, heatLoss rune) (int, error) {
+		if heatLoss < '0' || heatLoss > '9' {
+			return 0, fmt.Errorf("not a digit")
+		}
+
+		return int(heatLoss - '0'), nil
+	})
+}
+
+type DijkstraPoint struct {
+	Count      int
+	Direction  grid.Direction
+	Coordinate grid.Point
+}
+
+func (p DijkstraPoint) Move(direction grid.Direction) DijkstraPoint {
+	count := 1
+	if p.Direction == direction {
+		count = p.Count + 1
+	}
+
+	return DijkstraPoint{
+		Count:      count,
+		Direction:  direction,
+		Coordinate: p.Coordinate.Add(direction.ToVector()),
+	}
+}
+
+type DijkstraState struct {
+	Distance int
+	Point    DijkstraPoint
+	Previous *DijkstraState
+}
+
+type DijkstraStateMap map[DijkstraPoint]DijkstraState
+
+type DijkstraQueue []DijkstraState
+
+func (d DijkstraQueue) Len() int {
+	return len(d)
+}
+
+func (d DijkstraQueue) Less(i, j int) bool {
+	return d[i].Distance < d[j].Distance
+}
+
+func (d DijkstraQueue) Swap(i, j int) {
+	d[i], d[j] = d[j], d[i]
+}
+
+func (d *DijkstraQueue) Push(x any) {
+	*d = append(*d, x.(DijkstraState))
+}
+
+func (d *DijkstraQueue) Pop() any {
+	n := len(*d)
+
+	item := (*d)[n-1]
+	*d = (*d)[0 : n-1]
+
+	return item
+}
+
+func dijkstra(cityMap CityMap, crucible Crucible, start DijkstraPoint) DijkstraStateMap {
+	queue := make(DijkstraQueue, 1, cityMap.Width*cityMap.Height*4*3)
+	queue[0] = DijkstraState{Point: start}
+	heap.Init(&queue)
+
+	state := make(DijkstraStateMap, cap(queue))
+
+	for queue.Len() > 0 {
+		point := heap.Pop(&queue).(DijkstraState)
+
+		var nextPoints []DijkstraPoint
+		if point.Point.Count > 0 && point.Point.Count < crucible.MinStraight {
+			nextPoints = []DijkstraPoint{point.Point.Move(point.Point.Direction)}
+		} else {
+			nextPoints = make([]DijkstraPoint, 0, len(grid.Directions))
+			for _, direction := range grid.Directions {
+				nextPoints = append(nextPoints, point.Point.Move(direction))
+			}
+		}
+
+		for _, nextPoint := range nextPoints {
+			if !cityMap.InBounds(nextPoint.Coordinate) ||
+				nextPoint.Count > crucible.MaxStraight ||
+				nextPoint.Direction == point.Point.Direction.Reverse() {
+				continue
+			}
+
+			nextDistance := point.Distance + cityMap.At(nextPoint.Coordinate)
+			if nextState, ok := state[nextPoint]; !ok || nextDistance < nextState.Distance {
+				nextState := DijkstraState{
+					Distance: nextDistance,
+					Point:    nextPoint,
+					Previous: &point,
+				}
+
+				state[nextPoint] = nextState
+				heap.Push(&queue, nextState)
+			}
+		}
+	}
+
+	return state
+}
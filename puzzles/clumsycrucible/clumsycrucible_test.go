@@ -0,0 +1,42 @@
+package clumsycrucible
+
+import (
+	"testing"
+
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/internal/puzzletest"
+)
+
+func TestExamples(t *testing.T) {
+	puzzletest.Run(t, []puzzletest.Case{
+		{
+			Name:  "part 1",
+			Solve: Part1,
+			Input: "testdata/example1.txt",
+			Want:  102,
+		},
+		{
+			Name:  "part 2",
+			Solve: Part2,
+			Input: "testdata/example1.txt",
+			Want:  94,
+		},
+		{
+			Name:  "part 2 long straights",
+			Solve: Part2,
+			Input: "testdata/example2.txt",
+			Want:  71,
+		},
+	})
+}
+
+func BenchmarkParse(b *testing.B) {
+	puzzletest.Benchmark(b, 17, "testdata/example1.txt", ParseCityMap)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	puzzletest.Benchmark(b, 17, "testdata/example1.txt", Part1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	puzzletest.Benchmark(b, 17, "testdata/example1.txt", Part2)
+}
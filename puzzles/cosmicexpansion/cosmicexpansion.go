@@ -0,0 +1,157 @@
+// Package cosmicexpansion solves day 11 of Advent of Code 2023, "Cosmic Expansion".
+package cosmicexpansion
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/harmlessevil/advent-of-code-2023/grid"
+)
+
+type Location struct {
+	GalaxyID int
+}
+
+type Image struct {
+	ExpandedRows    map[int]struct{}
+	ExpandedColumns map[int]struct{}
+	Galaxies        []grid.Point
+	Locations       grid.Grid[Location]
+}
+
+func Part1(input io.Reader) (int, error) {
+	return solve(input, 2)
+}
+
+func Part2(input io.Reader) (int, error) {
+	return solve(input, 1_000_000)
+}
+
+// solve sums up distances between every pair of galaxies, when each empty row or column is expansionRate times bigger.
+func solve(input io.Reader, expansionRate int) (int, error) {
+	image, err := ParseImage(input)
+	if err != nil {
+		return 0, fmt.Errorf("parse image: %w", err)
+	}
+
+	return sumOfDistances(image, expansionRate), nil
+}
+
+func sumOfDistances(image Image, expansionRate int) int {
+	sum := 0
+
+	for i := 0; i < len(image.Galaxies); i++ {
+		for _, dist := range bfs(image, i+1, expansionRate) {
+			sum += dist
+		}
+	}
+
+	return sum
+}
+
+func ParseImage(r io.Reader) (Image, error) {
+	var image Image
+
+	locations, err := grid.Parse(r, func(point grid.Point, symbol rune) (Location, error) {
+		switch symbol {
+		case '.':
+			return Location{}, nil
+		case '#':
+			image.Galaxies = append(image.Galaxies, point)
+			return Location{GalaxyID: len(image.Galaxies)}, nil
+		}
+
+		return Location{}, fmt.Errorf("unknown symbol")
+	})
+	if err != nil {
+		return Image{}, err
+	}
+
+	image.Locations = locations
+	image.ExpandedRows = expandSpaceRows(image.Locations)
+	image.ExpandedColumns = expandSpaceRows(image.Locations.Transpose())
+
+	return image, nil
+}
+
+func expandSpaceRows(locations grid.Grid[Location]) map[int]struct{} {
+	expendedRows := map[int]struct{}{}
+
+searchEmptyRows:
+	for i := 0; i < locations.Height; i++ {
+		for _, loc := range locations.Row(i) {
+			if loc.GalaxyID != 0 {
+				continue searchEmptyRows
+			}
+		}
+
+		expendedRows[i] = struct{}{}
+	}
+
+	return expendedRows
+}
+
+type VisitStatus int
+
+const (
+	VisitStatusNotVisited VisitStatus = iota
+	VisitStatusPendingVisit
+	VisitStatusVisited
+)
+
+type BFSPoint struct {
+	Point    grid.Point
+	Distance int
+}
+
+func bfs(image Image, sourceGalaxyID int, expansionRate int) []int {
+	res := make([]int, len(image.Galaxies)-sourceGalaxyID)
+
+	target := make(map[int]struct{}, len(res))
+	for i := 0; i < len(res); i++ {
+		target[sourceGalaxyID+i+1] = struct{}{}
+	}
+
+	sourceGalaxy := image.Galaxies[sourceGalaxyID-1]
+	visitStatus := grid.New[VisitStatus](image.Locations.Width, image.Locations.Height)
+
+	for points := []BFSPoint{{Point: sourceGalaxy}}; len(points) > 0; points = points[1:] {
+		point := points[0]
+
+		visitStatus.Set(point.Point, VisitStatusVisited)
+
+		for _, nextPoint := range image.Locations.Neighbors4(point.Point) {
+			if visitStatus.At(nextPoint) != VisitStatusNotVisited {
+				continue
+			}
+
+			delta := nextPoint.Sub(point.Point)
+
+			distance := point.Distance + 1
+			if _, ok := image.ExpandedRows[nextPoint.Y]; ok && delta.Y != 0 {
+				distance += expansionRate - 1
+			}
+			if _, ok := image.ExpandedColumns[nextPoint.X]; ok && delta.X != 0 {
+				distance += expansionRate - 1
+			}
+
+			nextLocation := image.Locations.At(nextPoint)
+			if nextLocation.GalaxyID > sourceGalaxyID {
+				res[nextLocation.GalaxyID-sourceGalaxyID-1] = distance
+
+				delete(target, nextLocation.GalaxyID)
+				if len(target) == 0 {
+					return res
+				}
+			}
+
+			visitStatus.Set(nextPoint, VisitStatusPendingVisit)
+			points = append(points, BFSPoint{
+				Point:    nextPoint,
+				Distance: distance,
+			})
+		}
+	}
+
+	return res
+}
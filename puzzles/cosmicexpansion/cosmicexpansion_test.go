@@ -0,0 +1,55 @@
+package cosmicexpansion
+
+import (
+	"io"
+	"testing"
+
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/internal/puzzletest"
+)
+
+func TestExamples(t *testing.T) {
+	expandBy := func(expansionRate int) func(input io.Reader) (int, error) {
+		return func(input io.Reader) (int, error) {
+			return solve(input, expansionRate)
+		}
+	}
+
+	puzzletest.Run(t, []puzzletest.Case{
+		{
+			Name:  "part 1",
+			Solve: Part1,
+			Input: "testdata/example.txt",
+			Want:  374,
+		},
+		{
+			Name:  "part 2",
+			Solve: Part2,
+			Input: "testdata/example.txt",
+			Want:  82000210,
+		},
+		{
+			Name:  "10 times larger",
+			Solve: expandBy(10),
+			Input: "testdata/example.txt",
+			Want:  1030,
+		},
+		{
+			Name:  "100 times larger",
+			Solve: expandBy(100),
+			Input: "testdata/example.txt",
+			Want:  8410,
+		},
+	})
+}
+
+func BenchmarkParse(b *testing.B) {
+	puzzletest.Benchmark(b, 11, "testdata/example.txt", ParseImage)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	puzzletest.Benchmark(b, 11, "testdata/example.txt", Part1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	puzzletest.Benchmark(b, 11, "testdata/example.txt", Part2)
+}
@@ -0,0 +1,222 @@
+// Package cubeconundrum solves day 2 of Advent of Code 2023, "Cube Conundrum".
+package cubeconundrum
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+)
+
+// Cubes counts cubes of every color. Colors are arbitrary, a missing color means no cubes of it.
+type Cubes map[string]int
+
+type Game struct {
+	ID     int
+	Rounds []Cubes // cubes revealed in every round
+}
+
+var Bag = Cubes{
+	"red":   12,
+	"green": 13,
+	"blue":  14,
+}
+
+func Part1(input io.Reader) (int, error) {
+	games, err := ParseGames(input)
+	if err != nil {
+		return 0, fmt.Errorf("parse games: %w", err)
+	}
+
+	sum := 0
+	for _, game := range PossibleGames(games, Bag) {
+		sum += game.ID
+	}
+
+	return sum, nil
+}
+
+func Part2(input io.Reader) (int, error) {
+	games, err := ParseGames(input)
+	if err != nil {
+		return 0, fmt.Errorf("parse games: %w", err)
+	}
+
+	sum := 0
+	for _, game := range games {
+		sum += game.MinimalBag().Power()
+	}
+
+	return sum, nil
+}
+
+// Part1Report sums IDs of games possible with the bag.
+func Part1Report(input io.Reader) (Report, error) {
+	games, err := ParseGames(input)
+	if err != nil {
+		return Report{}, fmt.Errorf("parse games: %w", err)
+	}
+
+	report := NewReport(games, Bag)
+	for _, game := range PossibleGames(games, Bag) {
+		report.Sum += game.ID
+	}
+
+	return report, nil
+}
+
+// Part2Report sums powers of minimal bags of games.
+func Part2Report(input io.Reader) (Report, error) {
+	games, err := ParseGames(input)
+	if err != nil {
+		return Report{}, fmt.Errorf("parse games: %w", err)
+	}
+
+	report := NewReport(games, Bag)
+	for _, game := range report.Games {
+		report.Sum += game.Power
+	}
+
+	return report, nil
+}
+
+// PossibleGames returns games which could have been played with the bag.
+func PossibleGames(games []Game, bag Cubes) []Game {
+	var possible []Game
+	for _, game := range games {
+		if game.IsPossible(bag) {
+			possible = append(possible, game)
+		}
+	}
+
+	return possible
+}
+
+func (g Game) IsPossible(bag Cubes) bool {
+	return bag.Contains(g.MinimalBag())
+}
+
+// MinimalBag returns the fewest cubes of each color that could have been in the bag.
+func (g Game) MinimalBag() Cubes {
+	bag := make(Cubes, 3)
+	for _, round := range g.Rounds {
+		for color, amount := range round {
+			bag[color] = max(bag[color], amount)
+		}
+	}
+
+	return bag
+}
+
+// Contains reports whether there are at least as many cubes of every color as in other.
+func (c Cubes) Contains(other Cubes) bool {
+	for color, amount := range other {
+		if amount > c[color] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Power multiplies amounts of cubes of the colors present.
+func (c Cubes) Power() int {
+	power := 1
+	for _, amount := range c {
+		power *= amount
+	}
+
+	return power
+}
+
+// Colors returns the colors sorted.
+func (c Cubes) Colors() []string {
+	colors := make([]string, 0, len(c))
+	for color := range c {
+		colors = append(colors, color)
+	}
+
+	slices.Sort(colors)
+
+	return colors
+}
+
+func ParseGames(r io.Reader) ([]Game, error) {
+	var games []Game
+
+	scanner := parse.NewScanner(r)
+	for scanner.Scan() {
+		game, err := parseGame(scanner.Field())
+		if err != nil {
+			return nil, err
+		}
+
+		games = append(games, game)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan: %w", err)
+	}
+
+	return games, nil
+}
+
+// ParseBag parses cubes written like a round of a game, "12 red, 13 green, 14 blue".
+func ParseBag(text string) (Cubes, error) {
+	return parseCubes(parse.Field{Text: text, Column: 1})
+}
+
+func parseGame(line parse.Field) (Game, error) {
+	name, rounds, err := line.Cut(": ")
+	if err != nil {
+		return Game{}, err
+	}
+
+	id, err := name.TrimPrefix("Game ")
+	if err != nil {
+		return Game{}, err
+	}
+
+	gameID, err := id.Int()
+	if err != nil {
+		return Game{}, err
+	}
+
+	game := Game{ID: gameID}
+
+	for _, round := range rounds.Split("; ") {
+		cubes, err := parseCubes(round)
+		if err != nil {
+			return Game{}, err
+		}
+
+		game.Rounds = append(game.Rounds, cubes)
+	}
+
+	return game, nil
+}
+
+func parseCubes(field parse.Field) (Cubes, error) {
+	cubes := make(Cubes, 3)
+
+	for _, set := range field.Split(", ") {
+		amount, color, err := set.Cut(" ")
+		if err != nil {
+			return nil, err
+		}
+
+		n, err := amount.Int()
+		if err != nil {
+			return nil, err
+		}
+
+		if color.Text == "" {
+			return nil, color.Errorf("expected a color")
+		}
+
+		cubes[color.Text] += n
+	}
+
+	return cubes, nil
+}
@@ -0,0 +1,87 @@
+package cubeconundrum
+
+import (
+	"maps"
+	"strings"
+	"testing"
+
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/internal/puzzletest"
+)
+
+func TestExamples(t *testing.T) {
+	puzzletest.Run(t, []puzzletest.Case{
+		{
+			Name:  "part 1",
+			Solve: Part1,
+			Input: "testdata/example.txt",
+			Want:  8,
+		},
+		{
+			Name:  "part 2",
+			Solve: Part2,
+			Input: "testdata/example.txt",
+			Want:  2286,
+		},
+	})
+}
+
+func BenchmarkParse(b *testing.B) {
+	puzzletest.Benchmark(b, 2, "testdata/example.txt", ParseGames)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	puzzletest.Benchmark(b, 2, "testdata/example.txt", Part1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	puzzletest.Benchmark(b, 2, "testdata/example.txt", Part2)
+}
+
+func TestQueries(t *testing.T) {
+	games, err := ParseGames(strings.NewReader("Game 1: 3 blue, 4 red; 1 red, 2 yellow\nGame 7: 5 yellow; 2 blue, 2 yellow\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := games[1].Rounds; len(got) != 2 || got[1]["blue"] != 2 || got[1]["yellow"] != 2 {
+		t.Errorf("got rounds %v of game 7", got)
+	}
+
+	if got, want := games[0].MinimalBag(), (Cubes{"blue": 3, "red": 4, "yellow": 2}); !maps.Equal(got, want) {
+		t.Errorf("got minimal bag %v, want %v", got, want)
+	}
+
+	bag, err := ParseBag("4 blue, 4 red, 4 yellow")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if possible := PossibleGames(games, bag); len(possible) != 1 || possible[0].ID != 1 {
+		t.Errorf("got possible games %+v, want game 1", possible)
+	}
+
+	if possible := PossibleGames(games, Bag); len(possible) != 0 {
+		t.Errorf("got possible games %+v without yellow cubes in the bag", possible)
+	}
+
+	if _, err := ParseBag("4 blue, red"); err == nil {
+		t.Errorf("got no error for a bag without an amount")
+	}
+}
+
+func TestReportCSV(t *testing.T) {
+	games, err := ParseGames(strings.NewReader("Game 1: 3 blue, 4 red; 1 red, 2 green\nGame 2: 20 red\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var b strings.Builder
+	if err := NewReport(games, Bag).WriteCSV(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "id,rounds,possible,power,blue,green,red\n1,2,true,24,3,2,4\n2,1,false,20,0,0,20\n"
+	if b.String() != want {
+		t.Errorf("got\n%s\nwant\n%s", b.String(), want)
+	}
+}
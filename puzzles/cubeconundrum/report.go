@@ -0,0 +1,90 @@
+package cubeconundrum
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+// Report describes every game against a bag.
+type Report struct {
+	Sum   int          `json:"sum"`
+	Bag   Cubes        `json:"bag"`
+	Games []GameReport `json:"games"`
+}
+
+func (r Report) Answer() int {
+	return r.Sum
+}
+
+type GameReport struct {
+	ID         int   `json:"id"`
+	Rounds     int   `json:"rounds"`
+	MinimalBag Cubes `json:"minimal_bag"`
+	Power      int   `json:"power"`
+	Possible   bool  `json:"possible"` // with the bag of the report
+}
+
+// NewReport describes the games, leaving the sum to the caller.
+func NewReport(games []Game, bag Cubes) Report {
+	report := Report{
+		Bag:   bag,
+		Games: make([]GameReport, 0, len(games)),
+	}
+
+	for _, game := range games {
+		minimal := game.MinimalBag()
+
+		report.Games = append(report.Games, GameReport{
+			ID:         game.ID,
+			Rounds:     len(game.Rounds),
+			MinimalBag: minimal,
+			Power:      minimal.Power(),
+			Possible:   bag.Contains(minimal),
+		})
+	}
+
+	return report
+}
+
+// WriteCSV writes a row for every game, with a column for every color seen in the bag or any game.
+func (r Report) WriteCSV(w io.Writer) error {
+	all := make(Cubes, len(r.Bag))
+	for color := range r.Bag {
+		all[color] = 0
+	}
+
+	for _, game := range r.Games {
+		for color := range game.MinimalBag {
+			all[color] = 0
+		}
+	}
+
+	colors := all.Colors()
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(append([]string{"id", "rounds", "possible", "power"}, colors...)); err != nil {
+		return err
+	}
+
+	for _, game := range r.Games {
+		row := []string{
+			strconv.Itoa(game.ID),
+			strconv.Itoa(game.Rounds),
+			strconv.FormatBool(game.Possible),
+			strconv.Itoa(game.Power),
+		}
+
+		for _, color := range colors {
+			row = append(row, strconv.Itoa(game.MinimalBag[color]))
+		}
+
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
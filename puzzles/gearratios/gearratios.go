@@ -0,0 +1,259 @@
+// Package gearratios solves day 3 of Advent of Code 2023, "Gear Ratios".
+package gearratios
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+
+	"github.com/harmlessevil/advent-of-code-2023/grid"
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+)
+
+// Number in the schematic, spanning columns [Start, End) of the row.
+type Number struct {
+	Value int `json:"value"`
+	Row   int `json:"row"`
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+type Symbol struct {
+	Char     rune
+	Position grid.Point
+}
+
+// Report lists every symbol with its adjacent numbers.
+type Report struct {
+	Sum     int            `json:"sum"`
+	Symbols []SymbolReport `json:"symbols"`
+}
+
+func (r Report) Answer() int {
+	return r.Sum
+}
+
+type SymbolReport struct {
+	Char     string     `json:"char"`
+	Position grid.Point `json:"position"`
+	Numbers  []Number   `json:"numbers"`
+}
+
+func Part1(input io.Reader) (int, error) {
+	sum := 0
+	err := Walk(input, nil, func(number Number, symbols int) {
+		if symbols > 0 {
+			sum += number.Value
+		}
+	})
+
+	return sum, err
+}
+
+func Part2(input io.Reader) (int, error) {
+	sum := 0
+	err := Walk(input, func(symbol Symbol, numbers []Number) {
+		sum += gearRatio(symbol, numbers)
+	}, nil)
+
+	return sum, err
+}
+
+func Part1Report(input io.Reader) (Report, error) {
+	var report Report
+	err := Walk(input, report.addSymbol, func(number Number, symbols int) {
+		if symbols > 0 {
+			report.Sum += number.Value
+		}
+	})
+
+	return report, err
+}
+
+func Part2Report(input io.Reader) (Report, error) {
+	var report Report
+	err := Walk(input, func(symbol Symbol, numbers []Number) {
+		report.Sum += gearRatio(symbol, numbers)
+		report.addSymbol(symbol, numbers)
+	}, nil)
+
+	return report, err
+}
+
+func (r *Report) addSymbol(symbol Symbol, numbers []Number) {
+	r.Symbols = append(r.Symbols, SymbolReport{
+		Char:     string(symbol.Char),
+		Position: symbol.Position,
+		Numbers:  numbers,
+	})
+}
+
+// SymbolsWithNumbers returns symbols adjacent to exactly n numbers.
+func SymbolsWithNumbers(input io.Reader, n int) ([]SymbolReport, error) {
+	var report Report
+	err := Walk(input, func(symbol Symbol, numbers []Number) {
+		if len(numbers) == n {
+			report.addSymbol(symbol, numbers)
+		}
+	}, nil)
+
+	return report.Symbols, err
+}
+
+// gearRatio multiplies numbers adjacent to a gear, a '*' with exactly two of them, and is zero for other symbols.
+func gearRatio(symbol Symbol, numbers []Number) int {
+	if symbol.Char != '*' || len(numbers) != 2 {
+		return 0
+	}
+
+	slog.Debug("found gear", slog.Any("position", symbol.Position), slog.Any("numbers", numbers))
+
+	return numbers[0].Value * numbers[1].Value
+}
+
+// row of the schematic with an index of numbers by column.
+type row struct {
+	numbers []Number
+	symbols []Symbol
+	cells   []int // index of the number covering the column, or -1
+	counts  []int // symbols adjacent to every number
+}
+
+// Walk streams the schematic, keeping only three rows at a time. It calls symbol for every symbol with its adjacent
+// numbers, and number for every number with the count of its adjacent symbols, as soon as all neighbours of them are
+// read. Either callback may be nil.
+func Walk(input io.Reader, symbol func(Symbol, []Number), number func(Number, int)) error {
+	// Symbols of the middle row and numbers of the previous one have all their neighbours read.
+	var window [3]*row
+
+	advance := func(next *row) {
+		window[0], window[1], window[2] = window[1], window[2], next
+
+		if middle := window[1]; middle != nil {
+			for _, s := range middle.symbols {
+				numbers := adjacentNumbers(window, s.Position.X)
+				if symbol != nil {
+					symbol(s, numbers)
+				}
+			}
+		}
+
+		if prev := window[0]; prev != nil && number != nil {
+			for i, n := range prev.numbers {
+				number(n, prev.counts[i])
+			}
+		}
+	}
+
+	if err := scanRows(input, advance); err != nil {
+		return err
+	}
+
+	advance(nil)
+	advance(nil)
+
+	return nil
+}
+
+// Schematic is every number and symbol of the engine schematic, row by row.
+type Schematic struct {
+	Numbers []Number
+	Symbols []Symbol
+}
+
+// ParseSchematic reads the whole schematic, unlike Walk, which only keeps the rows it needs.
+func ParseSchematic(input io.Reader) (Schematic, error) {
+	var schematic Schematic
+	err := scanRows(input, func(r *row) {
+		schematic.Numbers = append(schematic.Numbers, r.numbers...)
+		schematic.Symbols = append(schematic.Symbols, r.symbols...)
+	})
+
+	return schematic, err
+}
+
+// scanRows parses rows of the schematic, checking that all of them are of the same width, and passes them to fn.
+func scanRows(input io.Reader, fn func(r *row)) error {
+	width := -1
+
+	scanner := parse.NewScanner(input)
+	for y := 0; scanner.Scan(); y++ {
+		line := []rune(scanner.Text())
+
+		if width == -1 {
+			width = len(line)
+		} else if len(line) != width {
+			return scanner.Errorf(0, "line has %d symbols, expected %d", len(line), width)
+		}
+
+		r, err := parseRow(line, y)
+		if err != nil {
+			return err
+		}
+
+		fn(r)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("scan: %w", err)
+	}
+
+	return nil
+}
+
+// adjacentNumbers finds numbers around the column of the middle row of the window, counting the symbol for them.
+func adjacentNumbers(window [3]*row, x int) []Number {
+	var numbers []Number
+
+	for _, r := range window {
+		if r == nil {
+			continue
+		}
+
+		last := -1
+		for i := max(x-1, 0); i < min(x+2, len(r.cells)); i++ {
+			if j := r.cells[i]; j != -1 && j != last {
+				numbers = append(numbers, r.numbers[j])
+				r.counts[j]++
+				last = j
+			}
+		}
+	}
+
+	return numbers
+}
+
+func parseRow(line []rune, y int) (*row, error) {
+	r := &row{cells: make([]int, len(line))}
+
+	for x := 0; x < len(line); x++ {
+		r.cells[x] = -1
+
+		switch char := line[x]; {
+		case char == '.':
+		case isDigit(char):
+			end := x
+			for ; end < len(line) && isDigit(line[end]); end++ {
+				r.cells[end] = len(r.numbers)
+			}
+
+			value, err := strconv.Atoi(string(line[x:end]))
+			if err != nil {
+				return nil, parse.Errorf(y+1, x+1, "parse number: %v", err)
+			}
+
+			r.numbers = append(r.numbers, Number{Value: value, Row: y, Start: x, End: end})
+			r.counts = append(r.counts, 0)
+			x = end - 1
+		default:
+			r.symbols = append(r.symbols, Symbol{Char: char, Position: grid.Point{X: x, Y: y}})
+		}
+	}
+
+	return r, nil
+}
+
+func isDigit(char rune) bool {
+	return '0' <= char && char <= '9'
+}
@@ -0,0 +1,88 @@
+package gearratios
+
+import (
+	"bytes"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/harmlessevil/advent-of-code-2023/grid"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/internal/puzzletest"
+)
+
+func TestExamples(t *testing.T) {
+	puzzletest.Run(t, []puzzletest.Case{
+		{
+			Name:  "part 1",
+			Solve: Part1,
+			Input: "testdata/example.txt",
+			Want:  4361,
+		},
+		{
+			Name:  "part 2",
+			Solve: Part2,
+			Input: "testdata/example.txt",
+			Want:  467835,
+		},
+	})
+}
+
+func BenchmarkParse(b *testing.B) {
+	puzzletest.Benchmark(b, 3, "testdata/example.txt", ParseSchematic)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	puzzletest.Benchmark(b, 3, "testdata/example.txt", Part1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	puzzletest.Benchmark(b, 3, "testdata/example.txt", Part2)
+}
+
+func TestSymbolsWithNumbers(t *testing.T) {
+	input, err := os.ReadFile("testdata/example.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	symbols, err := SymbolsWithNumbers(bytes.NewReader(input), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []SymbolReport{
+		{Char: "*", Position: grid.Point{X: 3, Y: 1}, Numbers: []Number{
+			{Value: 467, Row: 0, Start: 0, End: 3},
+			{Value: 35, Row: 2, Start: 2, End: 4},
+		}},
+		{Char: "*", Position: grid.Point{X: 5, Y: 8}, Numbers: []Number{
+			{Value: 755, Row: 7, Start: 6, End: 9},
+			{Value: 598, Row: 9, Start: 5, End: 8},
+		}},
+	}
+
+	if !reflect.DeepEqual(symbols, want) {
+		t.Errorf("got %+v, want %+v", symbols, want)
+	}
+
+	if symbols, err := SymbolsWithNumbers(bytes.NewReader(input), 1); err != nil || len(symbols) != 4 {
+		t.Errorf("got %d symbols with a single number, %v, want 4", len(symbols), err)
+	}
+}
+
+func TestNumberAdjacentToSeveralSymbols(t *testing.T) {
+	// 12 touches both symbols and counts once, 3 touches the symbol on the row below it.
+	report, err := Part1Report(strings.NewReader("#....\n.12.3\n*...$\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.Sum != 15 {
+		t.Errorf("got sum %d, want 15", report.Sum)
+	}
+
+	if len(report.Symbols) != 3 || len(report.Symbols[0].Numbers) != 1 || len(report.Symbols[2].Numbers) != 1 {
+		t.Errorf("got symbols %+v", report.Symbols)
+	}
+}
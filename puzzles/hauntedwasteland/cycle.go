@@ -0,0 +1,161 @@
+package hauntedwasteland
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/harmlessevil/advent-of-code-2023/numtheory"
+)
+
+// ErrNoMeeting is returned when walks are never at end nodes at the same step.
+var ErrNoMeeting = errors.New("walks are never at end nodes at the same step")
+
+// Cycle of a walk through the network. The walk is a sequence of states, a node and a place in the instructions, and
+// there are finitely many of them, so it ends up going around a cycle of states forever.
+type Cycle struct {
+	Prefix  int   `json:"prefix"` // steps before entering the cycle
+	Length  int   `json:"length"`
+	Ends    []int `json:"ends"`    // steps at end nodes before entering the cycle
+	Offsets []int `json:"offsets"` // steps at end nodes in the first round of the cycle, repeating every Length steps
+}
+
+// FindCycle walks from the start node until a state repeats.
+func FindCycle(network Network, start string, isEnd Selector) Cycle {
+	type state struct {
+		node string
+		i    int
+	}
+
+	seen := map[state]int{}
+	node := start
+
+	var ends []int
+	for step := 0; ; step++ {
+		s := state{node: node, i: step % len(network.Instructions)}
+
+		if first, ok := seen[s]; ok {
+			i, _ := slices.BinarySearch(ends, first)
+
+			return Cycle{
+				Prefix:  first,
+				Length:  step - first,
+				Ends:    ends[:i:i],
+				Offsets: ends[i:],
+			}
+		}
+
+		seen[s] = step
+
+		if isEnd(node) {
+			ends = append(ends, step)
+		}
+
+		node = network.Nodes[node][network.Instructions[s.i]]
+	}
+}
+
+// At reports whether the walk is at an end node at the step.
+func (c Cycle) At(step int) bool {
+	if step < c.Prefix {
+		_, ok := slices.BinarySearch(c.Ends, step)
+		return ok
+	}
+
+	_, ok := slices.BinarySearch(c.Offsets, c.Prefix+(step-c.Prefix)%c.Length)
+
+	return ok
+}
+
+// Meet returns the first step at which all walks are at end nodes.
+func Meet(cycles []Cycle) (int, error) {
+	if len(cycles) == 0 {
+		return 0, errors.New("no walks")
+	}
+
+	// Until all walks enter their cycles, try steps at which the first one is at an end node.
+	entered := 0
+	for _, c := range cycles {
+		entered = max(entered, c.Prefix)
+	}
+
+	first := cycles[0]
+	for _, step := range first.Ends {
+		if meet(cycles, step) {
+			return step, nil
+		}
+	}
+
+	for round := first.Prefix; round < entered; round += first.Length {
+		for _, offset := range first.Offsets {
+			if step := round + offset - first.Prefix; step < entered && meet(cycles, step) {
+				return step, nil
+			}
+		}
+	}
+
+	// After that, every walk is at end nodes at steps congruent to its offsets, so solve all combinations of them.
+	solutions := []congruence{{Residue: 0, Modulus: 1}}
+	for _, c := range cycles {
+		seen := map[congruence]bool{}
+
+		var next []congruence
+		for _, solution := range solutions {
+			for _, offset := range c.Offsets {
+				residue, modulus, ok, err := numtheory.CRT(solution.Residue, solution.Modulus, int64(offset%c.Length),
+					int64(c.Length))
+				if err != nil {
+					return 0, fmt.Errorf("period of meetings: %w", err)
+				}
+
+				if combined := (congruence{Residue: residue, Modulus: modulus}); ok && !seen[combined] {
+					seen[combined] = true
+					next = append(next, combined)
+				}
+			}
+		}
+
+		if len(next) == 0 {
+			return 0, ErrNoMeeting
+		}
+
+		solutions = next
+	}
+
+	best := int64(math.MaxInt64)
+	for _, solution := range solutions {
+		step := solution.Residue
+		if step < int64(entered) {
+			rounds := (int64(entered) - step + solution.Modulus - 1) / solution.Modulus
+
+			offset, err := numtheory.Mul(rounds, solution.Modulus)
+			if err == nil {
+				step, err = numtheory.Add(step, offset)
+			}
+
+			if err != nil {
+				return 0, fmt.Errorf("first meeting: %w", err)
+			}
+		}
+
+		best = min(best, step)
+	}
+
+	return int(best), nil
+}
+
+func meet(cycles []Cycle, step int) bool {
+	for _, c := range cycles {
+		if !c.At(step) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// congruence of numbers x ≡ Residue (mod Modulus), with 0 <= Residue < Modulus.
+type congruence struct {
+	Residue, Modulus int64
+}
@@ -0,0 +1,192 @@
+// Package hauntedwasteland solves day 8 of Advent of Code 2023, "Haunted Wasteland".
+package hauntedwasteland
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+)
+
+type Network struct {
+	Instructions []int
+	Nodes        map[string][2]string
+}
+
+// Report describes the walk from every start node.
+type Report struct {
+	Steps int    `json:"steps"`
+	Walks []Walk `json:"walks"`
+}
+
+func (r Report) Answer() int {
+	return r.Steps
+}
+
+type Walk struct {
+	Start string `json:"start"`
+	Cycle Cycle  `json:"cycle"`
+}
+
+func Part1(input io.Reader) (int, error) {
+	report, err := Part1Report(input)
+	return report.Steps, err
+}
+
+func Part2(input io.Reader) (int, error) {
+	report, err := Part2Report(input)
+	return report.Steps, err
+}
+
+func Part1Report(input io.Reader) (Report, error) {
+	network, err := ParseNetwork(input)
+	if err != nil {
+		return Report{}, fmt.Errorf("parse network: %w", err)
+	}
+
+	return Steps(network, Names("AAA"), Names("ZZZ"))
+}
+
+func Part2Report(input io.Reader) (Report, error) {
+	network, err := ParseNetwork(input)
+	if err != nil {
+		return Report{}, fmt.Errorf("parse network: %w", err)
+	}
+
+	return Steps(network, Suffix("A"), Suffix("Z"))
+}
+
+// Steps counts steps until walks from all start nodes are at end nodes at the same time.
+func Steps(network Network, start, end Selector) (Report, error) {
+	startNodes := network.Select(start)
+	if len(startNodes) == 0 {
+		return Report{}, errors.New("no start nodes")
+	}
+
+	report := Report{Walks: make([]Walk, 0, len(startNodes))}
+
+	cycles := make([]Cycle, 0, len(startNodes))
+	for _, node := range startNodes {
+		cycle := FindCycle(network, node, end)
+		if len(cycle.Ends) == 0 && len(cycle.Offsets) == 0 {
+			return Report{}, fmt.Errorf("walk from %s never reaches an end node", node)
+		}
+
+		slog.Debug("found cycle", slog.String("start", node), slog.Any("cycle", cycle))
+
+		cycles = append(cycles, cycle)
+		report.Walks = append(report.Walks, Walk{Start: node, Cycle: cycle})
+	}
+
+	steps, err := Meet(cycles)
+	if err != nil {
+		return Report{}, err
+	}
+
+	report.Steps = steps
+
+	return report, nil
+}
+
+func ParseNetwork(r io.Reader) (Network, error) {
+	scanner := parse.NewScanner(r)
+
+	if !scanner.Scan() {
+		return Network{}, scanner.Errorf(0, "expected instructions")
+	}
+
+	instructions, err := parseInstructions(scanner.Field())
+	if err != nil {
+		return Network{}, err
+	}
+
+	network := Network{
+		Instructions: instructions,
+		Nodes:        map[string][2]string{},
+	}
+
+	if scanner.Scan() && scanner.Text() != "" {
+		return Network{}, scanner.Errorf(1, "expected an empty line, got %q", scanner.Text())
+	}
+
+	var references []parse.Field // nodes pointed to, to check that all of them exist
+
+	for scanner.Scan() {
+		name, left, right, err := parseNode(scanner.Field())
+		if err != nil {
+			return Network{}, err
+		}
+
+		if _, ok := network.Nodes[name.Text]; ok {
+			return Network{}, name.Errorf("duplicate node %s", name.Text)
+		}
+
+		network.Nodes[name.Text] = [2]string{left.Text, right.Text}
+
+		references = append(references, left, right)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return Network{}, fmt.Errorf("scan: %w", err)
+	}
+
+	for _, reference := range references {
+		if _, ok := network.Nodes[reference.Text]; !ok {
+			return Network{}, reference.Errorf("unknown node %s", reference.Text)
+		}
+	}
+
+	return network, nil
+}
+
+// parseNode parses a node like "AAA = (BBB, CCC)". Names may be of any length, but can't have spaces or punctuation
+// of the format.
+func parseNode(line parse.Field) (name, left, right parse.Field, err error) {
+	name, rest, err := line.Cut(" = ")
+	if err != nil {
+		return name, left, right, err
+	}
+
+	if rest, err = rest.TrimPrefix("("); err != nil {
+		return name, left, right, err
+	}
+
+	if rest, err = rest.TrimSuffix(")"); err != nil {
+		return name, left, right, err
+	}
+
+	if left, right, err = rest.Cut(", "); err != nil {
+		return name, left, right, err
+	}
+
+	for _, field := range []parse.Field{name, left, right} {
+		if field.Text == "" || strings.ContainsAny(field.Text, " \t=(),") {
+			return name, left, right, field.Errorf("invalid node name %q", field.Text)
+		}
+	}
+
+	return name, left, right, nil
+}
+
+func parseInstructions(line parse.Field) ([]int, error) {
+	if line.Text == "" {
+		return nil, line.Errorf("expected instructions")
+	}
+
+	instructions := make([]int, len(line.Text))
+	for i, direction := range line.Text {
+		switch direction {
+		case 'L':
+			instructions[i] = 0
+		case 'R':
+			instructions[i] = 1
+		default:
+			return nil, parse.Errorf(line.Line, line.Column+i, "unknown direction %q", direction)
+		}
+	}
+
+	return instructions, nil
+}
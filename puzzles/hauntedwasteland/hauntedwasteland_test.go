@@ -0,0 +1,185 @@
+package hauntedwasteland
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math/rand"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/internal/puzzletest"
+)
+
+func TestExamples(t *testing.T) {
+	puzzletest.Run(t, []puzzletest.Case{
+		{
+			Name:  "part 1",
+			Solve: Part1,
+			Input: "testdata/example1.txt",
+			Want:  2,
+		},
+		{
+			Name:  "part 1 repeating instructions",
+			Solve: Part1,
+			Input: "testdata/example2.txt",
+			Want:  6,
+		},
+		{
+			Name:  "part 2",
+			Solve: Part2,
+			Input: "testdata/example3.txt",
+			Want:  6,
+		},
+	})
+}
+
+func BenchmarkParse(b *testing.B) {
+	puzzletest.Benchmark(b, 8, "testdata/example1.txt", ParseNetwork)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	puzzletest.Benchmark(b, 8, "testdata/example1.txt", Part1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	puzzletest.Benchmark(b, 8, "testdata/example3.txt", Part2)
+}
+
+func TestFindCycle(t *testing.T) {
+	network, err := ParseNetwork(strings.NewReader("LR\n\n11A = (11B, XXX)\n11B = (XXX, 11Z)\n11Z = (11B, XXX)\n" +
+		"XXX = (XXX, XXX)\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cycle := FindCycle(network, "11A", func(node string) bool {
+		return node == "11Z"
+	})
+
+	want := Cycle{Prefix: 1, Length: 2, Ends: []int{}, Offsets: []int{2}}
+	if !reflect.DeepEqual(cycle, want) {
+		t.Errorf("got %+v, want %+v", cycle, want)
+	}
+
+	if _, err = Steps(network, Names("11A"), Names("ZZZ")); err == nil {
+		t.Error("got no error for an unreachable end node")
+	}
+}
+
+// TestMeetAgainstBruteForce compares steps found by cycles with walking all start nodes together, on random networks
+// with walks reaching end nodes several times before and within their cycles.
+func TestMeetAgainstBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 500; i++ {
+		network := Network{Nodes: map[string][2]string{}}
+		for j := rng.Intn(3); j >= 0; j-- {
+			network.Instructions = append(network.Instructions, rng.Intn(2))
+		}
+
+		var names []string
+		for j := rng.Intn(6) + 2; j > 0; j-- {
+			names = append(names, fmt.Sprintf("N%d", j))
+		}
+
+		for _, name := range names {
+			network.Nodes[name] = [2]string{names[rng.Intn(len(names))], names[rng.Intn(len(names))]}
+		}
+
+		ends := map[string]bool{}
+		for _, name := range names {
+			ends[name] = rng.Intn(3) == 0
+		}
+
+		isEnd := func(node string) bool {
+			return ends[node]
+		}
+
+		starts := names[:min(rng.Intn(3)+1, len(names))]
+
+		var cycles []Cycle
+		for _, start := range starts {
+			cycles = append(cycles, FindCycle(network, start, isEnd))
+		}
+
+		want := -1
+		nodes := slices.Clone(starts)
+		for step := 0; step < 10000 && want == -1; step++ {
+			if !slices.ContainsFunc(nodes, func(node string) bool { return !ends[node] }) {
+				want = step
+			}
+
+			for j, node := range nodes {
+				nodes[j] = network.Nodes[node][network.Instructions[step%len(network.Instructions)]]
+			}
+		}
+
+		got, err := Meet(cycles)
+		if want == -1 && !errors.Is(err, ErrNoMeeting) || want != -1 && (err != nil || got != want) {
+			t.Fatalf("network %v from %v to %v: got %d, %v, want %d", network, starts, ends, got, err, want)
+		}
+	}
+}
+
+func TestParseNetwork(t *testing.T) {
+	network, err := ParseNetwork(strings.NewReader("RL\n\nstart = (x, goal-1)\nx = (x, x)\ngoal-1 = (start, goal-1)\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string][2]string{"start": {"x", "goal-1"}, "x": {"x", "x"}, "goal-1": {"start", "goal-1"}}
+	if !maps.Equal(network.Nodes, want) {
+		t.Errorf("got nodes %v, want %v", network.Nodes, want)
+	}
+
+	report, err := Steps(network, Names("start"), Matching(regexp.MustCompile(`^goal-\d+$`)))
+	if err != nil || report.Steps != 1 {
+		t.Errorf("got %d steps, %v, want 1", report.Steps, err)
+	}
+
+	for _, line := range []string{"AAA = (BBB CCC)", "AAA = BBB, CCC", "AAA = (, CCC)", "A A = (BBB, CCC)", "AAA"} {
+		_, err := ParseNetwork(strings.NewReader("L\n\n" + line + "\n"))
+
+		var parseErr *parse.Error
+		if !errors.As(err, &parseErr) || parseErr.Line != 3 {
+			t.Errorf("%s: got %v, want an error on line 3", line, err)
+		}
+	}
+}
+
+func TestParseSelector(t *testing.T) {
+	nodes := map[string][2]string{}
+	for _, node := range []string{"AAA", "ZZZ", "11A", "11Z", "BZ"} {
+		nodes[node] = [2]string{node, node}
+	}
+
+	network := Network{Instructions: []int{0}, Nodes: nodes}
+
+	for text, want := range map[string]string{
+		"suffix:Z":    "11Z BZ ZZZ",
+		"name:AAA":    "AAA",
+		"regexp:^1+.": "11A 11Z",
+		"name:":       "",
+	} {
+		selector, err := ParseSelector(text)
+		if err != nil {
+			t.Errorf("%s: %v", text, err)
+			continue
+		}
+
+		if got := strings.Join(network.Select(selector), " "); got != want {
+			t.Errorf("%s: got %s, want %s", text, got, want)
+		}
+	}
+
+	for _, text := range []string{"Z", "prefix:A", "regexp:("} {
+		if _, err := ParseSelector(text); err == nil {
+			t.Errorf("%s: got no error", text)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package hauntedwasteland
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Selector picks nodes by name, like start or end nodes of walks.
+type Selector func(node string) bool
+
+// Suffix selects nodes with names ending with the suffix, like ghosts starting at nodes ending with A.
+func Suffix(suffix string) Selector {
+	return func(node string) bool {
+		return strings.HasSuffix(node, suffix)
+	}
+}
+
+// Names selects nodes with exactly one of the names.
+func Names(names ...string) Selector {
+	return func(node string) bool {
+		return slices.Contains(names, node)
+	}
+}
+
+// Matching selects nodes with names matching the regular expression.
+func Matching(re *regexp.Regexp) Selector {
+	return re.MatchString
+}
+
+// ParseSelector parses a selector like "suffix:Z", "name:ZZZ" or "regexp:^Z+$".
+func ParseSelector(text string) (Selector, error) {
+	kind, value, ok := strings.Cut(text, ":")
+	if !ok {
+		return nil, fmt.Errorf("expected a selector like \"suffix:Z\", got %q", text)
+	}
+
+	switch kind {
+	case "suffix":
+		return Suffix(value), nil
+	case "name":
+		return Names(value), nil
+	case "regexp":
+		re, err := regexp.Compile(value)
+		if err != nil {
+			return nil, fmt.Errorf("selector %q: %w", text, err)
+		}
+
+		return Matching(re), nil
+	default:
+		return nil, fmt.Errorf("unknown kind of selector %q, expected suffix, name or regexp", kind)
+	}
+}
+
+// Select returns names of the selected nodes, sorted.
+func (n Network) Select(selector Selector) []string {
+	var nodes []string
+	for node := range n.Nodes {
+		if selector(node) {
+			nodes = append(nodes, node)
+		}
+	}
+
+	slices.Sort(nodes)
+
+	return nodes
+}
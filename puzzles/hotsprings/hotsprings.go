@@ -0,0 +1,281 @@
+// Package hotsprings solves day 12 of Advent of Code 2023, "Hot Springs".
+package hotsprings
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/internal/lines"
+)
+
+type CacheKey struct {
+	Springs      string
+	DamagedCount [MaxGroups]int // abuse input property: it's guaranteed to have at most 6 * 5 numbers
+}
+
+type Cache map[CacheKey]int
+
+type Record struct {
+	Springs      string
+	DamagedCount []int
+}
+
+// Report is the number of arrangements of every record and their sum.
+type Report struct {
+	Sum     int            `json:"sum"`
+	Records []RecordReport `json:"records"`
+}
+
+func (r Report) Answer() int {
+	return r.Sum
+}
+
+type RecordReport struct {
+	Springs      string `json:"springs"`
+	DamagedCount []int  `json:"damaged_count"`
+	Arrangements int    `json:"arrangements"`
+}
+
+func Part1(input io.Reader) (int, error) {
+	return solve(input, false, nil)
+}
+
+func Part2(input io.Reader) (int, error) {
+	return solve(input, true, nil)
+}
+
+func Part1Report(input io.Reader) (Report, error) {
+	return solveReport(input, false)
+}
+
+func Part2Report(input io.Reader) (Report, error) {
+	return solveReport(input, true)
+}
+
+func solveReport(input io.Reader, unfoldRecords bool) (Report, error) {
+	var report Report
+
+	sum, err := solve(input, unfoldRecords, func(record RecordReport) {
+		report.Records = append(report.Records, record)
+	})
+	if err != nil {
+		return Report{}, err
+	}
+
+	report.Sum = sum
+
+	return report, nil
+}
+
+// solve sums arrangements of records counted on several goroutines, every record with its own cache. Visit, if not
+// nil, is called on every record in order.
+func solve(input io.Reader, unfoldRecords bool, visit func(RecordReport)) (int, error) {
+	sum := 0
+
+	err := lines.Process(input, lines.Options{}, func(line parse.Field) (RecordReport, error) {
+		record, err := parseRecord(line)
+		if err != nil {
+			return RecordReport{}, fmt.Errorf("parse record: %w", err)
+		}
+
+		springs, damagedCount := record.Springs, record.DamagedCount
+		if unfoldRecords {
+			springs, damagedCount = unfold(springs, damagedCount)
+		}
+
+		if len(damagedCount) > MaxGroups {
+			return RecordReport{}, fmt.Errorf("record %q has %d damaged groups, at most %d are supported", springs, len(damagedCount), MaxGroups)
+		}
+
+		arrangements := countArrangements(Cache{}, springs, toArray(damagedCount), len(damagedCount))
+		slog.Debug(
+			"counted arrangements",
+			slog.String("springs", record.Springs),
+			slog.Any("damaged", damagedCount),
+			slog.Int("arrangements", arrangements),
+		)
+
+		return RecordReport{
+			Springs:      record.Springs,
+			DamagedCount: record.DamagedCount,
+			Arrangements: arrangements,
+		}, nil
+	}, func(record RecordReport) error {
+		sum += record.Arrangements
+		if visit != nil {
+			visit(record)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return sum, nil
+}
+
+func ParseRecords(r io.Reader) ([]Record, error) {
+	var records []Record
+
+	scanner := parse.NewScanner(r)
+
+	for scanner.Scan() {
+		record, err := parseRecord(scanner.Field())
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan: %w", err)
+	}
+
+	return records, nil
+}
+
+func parseRecord(line parse.Field) (Record, error) {
+	springs, counts, err := line.Cut(" ")
+	if err != nil {
+		return Record{}, err
+	}
+
+	if i := strings.IndexFunc(springs.Text, func(spring rune) bool {
+		return !strings.ContainsRune(".#?", spring)
+	}); i != -1 {
+		return Record{}, parse.Errorf(springs.Line, springs.Column+i, "unknown spring %q", springs.Text[i])
+	}
+
+	var damagedCount []int
+	for _, item := range counts.Split(",") {
+		count, err := item.Int()
+		if err != nil {
+			return Record{}, err
+		}
+
+		if count < 1 {
+			return Record{}, item.Errorf("damaged group of %d springs, expected at least 1", count)
+		}
+
+		damagedCount = append(damagedCount, count)
+	}
+
+	return Record{
+		Springs:      springs.Text,
+		DamagedCount: damagedCount,
+	}, nil
+}
+
+func unfold(springs string, damagedCount []int) (string, []int) {
+	unfoldedDamagedCount := make([]int, 0, len(damagedCount)*5)
+	springsParts := make([]string, 5)
+
+	for i := 0; i < 5; i++ {
+		springsParts[i] = springs
+		unfoldedDamagedCount = append(unfoldedDamagedCount, damagedCount...)
+	}
+
+	return strings.Join(springsParts, "?"), unfoldedDamagedCount
+}
+
+// MaxGroups limits the amount of damaged groups in a record, so that they fit into a cache key.
+const MaxGroups = 6 * 5
+
+func toArray(s []int) [MaxGroups]int {
+	var res [MaxGroups]int
+	copy(res[:], s)
+
+	return res
+}
+
+func countArrangements(cache Cache, springs string, damagedCount [MaxGroups]int, groupCount int) int {
+	if groupCount == 0 {
+		if couldBeAllOperational(springs) {
+			return 1
+		}
+
+		return 0
+	}
+
+	target := damagedCount[0]
+	arrangements := 0
+
+	var match bool
+	for i := 0; i < len(springs)-target+1; i++ {
+		match = true
+		for _, spring := range springs[i : i+target] {
+			if spring == '.' {
+				match = false
+				break
+			}
+		}
+
+		isValid := i+target < len(springs) && springs[i+target] != '#'
+		if match {
+			logMatch(springs, i, target)
+
+			if isValid {
+				cacheKey := CacheKey{
+					Springs:      springs[i+target+1:],
+					DamagedCount: toArray(damagedCount[1:]),
+				}
+
+				arrangementsInSuffix, ok := cache[cacheKey]
+				if !ok {
+					arrangementsInSuffix = countArrangements(cache, cacheKey.Springs, cacheKey.DamagedCount, groupCount-1)
+					cache[cacheKey] = arrangementsInSuffix
+					slog.Debug("cache miss")
+				} else {
+					slog.Debug("cache hit")
+				}
+
+				if arrangementsInSuffix == 0 {
+					isValid = false
+				}
+
+				arrangements += arrangementsInSuffix
+			} else if i+target >= len(springs) && groupCount == 1 {
+				arrangements++
+			}
+		}
+
+		if springs[i] == '#' {
+			if match && groupCount == 1 && arrangements == 0 && isValid {
+				return 1
+			}
+
+			return arrangements
+		}
+	}
+
+	if match && groupCount == 1 && arrangements == 0 {
+		return 1
+	}
+
+	return arrangements
+}
+
+func logMatch(springs string, i int, target int) {
+	slog.Debug(
+		"matched",
+		slog.String("springs", springs),
+		slog.String("pattern", springs[i:i+target]),
+		slog.String("match", springs[:i]+strings.Repeat("#", target)+springs[i+target:]),
+		slog.Int("target", target),
+	)
+}
+
+func couldBeAllOperational(springs string) bool {
+	for _, spring := range springs {
+		if spring == '#' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,206 @@
+package ifyougiveaseedafertilizer
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"sort"
+)
+
+// Function is a piecewise-linear function of numbers, like a map or a chain of them. Pieces are ranges adding their
+// value to numbers in them, sorted by start and not overlapping; numbers outside of them map to themselves.
+type Function []Range
+
+// Segment of source numbers mapped to destination ones by a single piece.
+type Segment struct {
+	Source      Range `json:"source"`
+	Destination Range `json:"destination"`
+}
+
+// Function composes maps converting the source category to the target one.
+func (a Almanac) Function(source, target string) (Function, error) {
+	path, err := a.Path(source, target)
+	if err != nil {
+		return nil, err
+	}
+
+	var f Function
+	for _, m := range path {
+		f = Compose(f, m.Ranges)
+	}
+
+	return f, nil
+}
+
+// Compose returns the function applying first, then second.
+func Compose(first, second Function) Function {
+	lo, hi := first.bounds(second)
+
+	var res Function
+	for _, piece := range first.cover(Range{Start: lo, End: hi}) {
+		image := Range{Start: piece.Start + piece.Value, End: piece.End + piece.Value}
+
+		for _, next := range second.cover(image) {
+			res = append(res, Range{
+				Start: next.Start - piece.Value,
+				End:   next.End - piece.Value,
+				Value: piece.Value + next.Value,
+			})
+		}
+	}
+
+	return res.normalize()
+}
+
+// Apply maps the number.
+func (f Function) Apply(n int) int {
+	i := sort.Search(len(f), func(i int) bool {
+		return f[i].End > n
+	})
+	if i == len(f) || f[i].Start > n {
+		return n
+	}
+
+	return n + f[i].Value
+}
+
+// Image splits the range into segments mapped by a single piece each, sorted by destination.
+func (f Function) Image(r Range) []Segment {
+	var segments []Segment
+	for _, piece := range f.cover(r) {
+		segments = append(segments, Segment{
+			Source:      Range{Start: piece.Start, End: piece.End},
+			Destination: Range{Start: piece.Start + piece.Value, End: piece.End + piece.Value},
+		})
+	}
+
+	slices.SortFunc(segments, func(a, b Segment) int {
+		return cmp.Compare(a.Destination.Start, b.Destination.Start)
+	})
+
+	return segments
+}
+
+// Preimage returns ranges of numbers mapped into the range, sorted and merged. Maps aren't one-to-one, so the
+// preimage may have several ranges.
+func (f Function) Preimage(r Range) []Range {
+	lo, hi := f.bounds(Function{r})
+
+	var res []Range
+	for _, piece := range f.cover(Range{Start: lo, End: hi}) {
+		start := max(piece.Start+piece.Value, r.Start)
+		end := min(piece.End+piece.Value, r.End)
+
+		if start < end {
+			res = append(res, Range{Start: start - piece.Value, End: end - piece.Value})
+		}
+	}
+
+	return mergeRanges(res)
+}
+
+// Origins returns parts of the source ranges mapped into the target range, like seeds landing in some locations.
+func (f Function) Origins(sources []Range, target Range) []Range {
+	var res []Range
+	for _, source := range mergeRanges(slices.Clone(sources)) {
+		for _, r := range f.Preimage(target) {
+			if start, end := max(source.Start, r.Start), min(source.End, r.End); start < end {
+				res = append(res, Range{Start: start, End: end})
+			}
+		}
+	}
+
+	return res
+}
+
+// cover returns pieces of the function within the range, filling gaps between them with pieces adding zero.
+func (f Function) cover(r Range) []Range {
+	var res []Range
+
+	start := r.Start
+	for _, piece := range f {
+		if piece.End <= start {
+			continue
+		}
+
+		if piece.Start >= r.End {
+			break
+		}
+
+		if piece.Start > start {
+			res = append(res, Range{Start: start, End: piece.Start})
+			start = piece.Start
+		}
+
+		end := min(piece.End, r.End)
+		res = append(res, Range{Start: start, End: end, Value: piece.Value})
+		start = end
+	}
+
+	if start < r.End {
+		res = append(res, Range{Start: start, End: r.End})
+	}
+
+	return res
+}
+
+// bounds returns a range containing pieces of both functions.
+func (f Function) bounds(other Function) (lo, hi int) {
+	lo, hi = math.MaxInt, math.MinInt
+	for _, pieces := range []Function{f, other} {
+		if len(pieces) > 0 {
+			lo = min(lo, pieces[0].Start)
+			hi = max(hi, pieces[len(pieces)-1].End)
+		}
+	}
+
+	if lo > hi {
+		return 0, 0
+	}
+
+	return lo, hi
+}
+
+// normalize sorts pieces, merges adjacent ones adding the same value and drops ones adding zero.
+func (f Function) normalize() Function {
+	slices.SortFunc(f, func(a, b Range) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+
+	var res Function
+	for _, piece := range f {
+		if piece.Start >= piece.End {
+			continue
+		}
+
+		if last := len(res) - 1; last >= 0 && res[last].End == piece.Start && res[last].Value == piece.Value {
+			res[last].End = piece.End
+			continue
+		}
+
+		res = append(res, piece)
+	}
+
+	return slices.DeleteFunc(res, func(piece Range) bool {
+		return piece.Value == 0
+	})
+}
+
+// mergeRanges sorts ranges and merges overlapping and adjacent ones, ignoring their values.
+func mergeRanges(ranges []Range) []Range {
+	slices.SortFunc(ranges, func(a, b Range) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+
+	var res []Range
+	for _, r := range ranges {
+		if last := len(res) - 1; last >= 0 && r.Start <= res[last].End {
+			res[last].End = max(res[last].End, r.End)
+			continue
+		}
+
+		res = append(res, Range{Start: r.Start, End: r.End})
+	}
+
+	return res
+}
@@ -0,0 +1,392 @@
+// Package ifyougiveaseedafertilizer solves day 5 of Advent of Code 2023, "If You Give A Seed A Fertilizer".
+package ifyougiveaseedafertilizer
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+)
+
+// Almanac is a graph of categories connected by maps.
+type Almanac struct {
+	Seeds []int
+	Maps  []Map // in the order of the input
+}
+
+// Map converts numbers of the source category to the destination one.
+type Map struct {
+	Source      string
+	Destination string
+	Ranges      []Range // sorted by start
+}
+
+// Categories the puzzle asks about.
+const (
+	Seed     = "seed"
+	Location = "location"
+)
+
+// Report tells which seeds end up in which locations.
+type Report struct {
+	Lowest   int       `json:"lowest"`
+	Segments []Segment `json:"segments"` // seeds mapped to locations, sorted by location
+}
+
+func (r Report) Answer() int {
+	return r.Lowest
+}
+
+func Part1(input io.Reader) (int, error) {
+	almanac, err := ParseAlmanac(input)
+	if err != nil {
+		return 0, fmt.Errorf("parse almanac: %w", err)
+	}
+
+	return lowest(almanac, singleSeeds(almanac.Seeds))
+}
+
+func Part2(input io.Reader) (int, error) {
+	almanac, err := ParseAlmanac(input)
+	if err != nil {
+		return 0, fmt.Errorf("parse almanac: %w", err)
+	}
+
+	seeds, err := seedRanges(almanac.Seeds)
+	if err != nil {
+		return 0, err
+	}
+
+	return lowest(almanac, seeds)
+}
+
+func Part1Report(input io.Reader) (Report, error) {
+	almanac, err := ParseAlmanac(input)
+	if err != nil {
+		return Report{}, fmt.Errorf("parse almanac: %w", err)
+	}
+
+	return explain(almanac, singleSeeds(almanac.Seeds))
+}
+
+func Part2Report(input io.Reader) (Report, error) {
+	almanac, err := ParseAlmanac(input)
+	if err != nil {
+		return Report{}, fmt.Errorf("parse almanac: %w", err)
+	}
+
+	seeds, err := seedRanges(almanac.Seeds)
+	if err != nil {
+		return Report{}, err
+	}
+
+	return explain(almanac, seeds)
+}
+
+// singleSeeds makes a range of every seed, as part 1 reads them.
+func singleSeeds(seeds []int) []Range {
+	ranges := make([]Range, len(seeds))
+	for i, seed := range seeds {
+		ranges[i] = Range{
+			Start: seed,
+			End:   seed + 1,
+		}
+	}
+
+	return ranges
+}
+
+// lowest maps the seeds to locations through the composed maps, keeping only the lowest location.
+func lowest(almanac Almanac, seeds []Range) (int, error) {
+	f, err := almanac.Function(Seed, Location)
+	if err != nil {
+		return 0, err
+	}
+
+	found := false
+	location := 0
+	for _, r := range seeds {
+		for _, segment := range f.Image(r) {
+			if !found || segment.Destination.Start < location {
+				found, location = true, segment.Destination.Start
+			}
+		}
+	}
+
+	if !found {
+		return 0, errors.New("no seeds")
+	}
+
+	return location, nil
+}
+
+// explain maps the seeds to locations through the composed maps.
+func explain(almanac Almanac, seeds []Range) (Report, error) {
+	f, err := almanac.Function(Seed, Location)
+	if err != nil {
+		return Report{}, err
+	}
+
+	var report Report
+	for _, r := range seeds {
+		report.Segments = append(report.Segments, f.Image(r)...)
+	}
+
+	if len(report.Segments) == 0 {
+		return Report{}, errors.New("no seeds")
+	}
+
+	slices.SortFunc(report.Segments, func(a, b Segment) int {
+		return cmp.Compare(a.Destination.Start, b.Destination.Start)
+	})
+
+	report.Lowest = report.Segments[0].Destination.Start
+
+	return report, nil
+}
+
+// ParseAlmanac reads maps in any order, and checks that they lead from seeds to locations.
+func ParseAlmanac(r io.Reader) (Almanac, error) {
+	scanner := parse.NewScanner(r)
+
+	if !scanner.Scan() {
+		return Almanac{}, scanner.Errorf(0, "expected seeds")
+	}
+
+	seeds, err := parseSeeds(scanner.Field())
+	if err != nil {
+		return Almanac{}, err
+	}
+
+	almanac := Almanac{Seeds: seeds}
+
+	if scanner.Scan() && scanner.Text() != "" {
+		return Almanac{}, scanner.Errorf(1, "expected an empty line, got %q", scanner.Text())
+	}
+
+	seen := make(map[[2]string]int) // line of the header by categories
+
+	for scanner.Scan() {
+		source, destination, err := parseHeader(scanner.Field())
+		if err != nil {
+			return Almanac{}, err
+		}
+
+		categories := [2]string{source, destination}
+		if line, ok := seen[categories]; ok {
+			return Almanac{}, scanner.Errorf(1, "%s-to-%s map is repeated, first seen on line %d", source, destination, line)
+		}
+
+		seen[categories] = scanner.Line()
+
+		ranges, err := parseMap(scanner)
+		if err != nil {
+			return Almanac{}, fmt.Errorf("parse %s-to-%s map: %w", source, destination, err)
+		}
+
+		almanac.Maps = append(almanac.Maps, Map{
+			Source:      source,
+			Destination: destination,
+			Ranges:      ranges,
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return Almanac{}, fmt.Errorf("scan: %w", err)
+	}
+
+	if _, err := almanac.Path(Seed, Location); err != nil {
+		return Almanac{}, err
+	}
+
+	return almanac, nil
+}
+
+// parseHeader parses "source-to-destination map:".
+func parseHeader(line parse.Field) (source, destination string, err error) {
+	name, err := line.TrimSuffix(" map:")
+	if err != nil {
+		return "", "", err
+	}
+
+	from, to, err := name.Cut("-to-")
+	if err != nil {
+		return "", "", err
+	}
+
+	if from.Text == "" || to.Text == "" {
+		return "", "", line.Errorf("expected a map from a category to another, got %q", line.Text)
+	}
+
+	if from.Text == to.Text {
+		return "", "", to.Errorf("%s map to itself", to.Text)
+	}
+
+	return from.Text, to.Text, nil
+}
+
+// Path returns maps converting the source category to the target one, through as few categories as possible.
+func (a Almanac) Path(source, target string) ([]Map, error) {
+	// Maps leading to every reached category, to walk the path back.
+	via := map[string]int{source: -1}
+	queue := []string{source}
+
+	for len(queue) > 0 {
+		if _, ok := via[target]; ok {
+			break
+		}
+
+		category := queue[0]
+		queue = queue[1:]
+
+		for i, m := range a.Maps {
+			if _, ok := via[m.Destination]; !ok && m.Source == category {
+				via[m.Destination] = i
+				queue = append(queue, m.Destination)
+			}
+		}
+	}
+
+	if _, ok := via[target]; !ok {
+		return nil, a.brokenChain(source, target, via)
+	}
+
+	var path []Map
+	for category := target; category != source; {
+		m := a.Maps[via[category]]
+		path = append(path, m)
+		category = m.Source
+	}
+
+	slices.Reverse(path)
+
+	return path, nil
+}
+
+// brokenChain explains why the target can't be reached, naming reached categories without maps from them.
+func (a Almanac) brokenChain(source, target string, reached map[string]int) error {
+	var deadEnds []string
+	for category := range reached {
+		if !slices.ContainsFunc(a.Maps, func(m Map) bool { return m.Source == category }) {
+			deadEnds = append(deadEnds, category)
+		}
+	}
+
+	slices.Sort(deadEnds)
+
+	if len(deadEnds) == 0 {
+		return fmt.Errorf("no maps lead from %s to %s", source, target)
+	}
+
+	return fmt.Errorf("no maps lead from %s to %s: the chain breaks at %s, which has no maps from it", source, target,
+		strings.Join(deadEnds, ", "))
+}
+
+// Convert maps ranges of the source category to the target one. Resulting ranges are sorted by start and may overlap.
+func (a Almanac) Convert(source, target string, ranges []Range) ([]Range, error) {
+	f, err := a.Function(source, target)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []Range
+	for _, r := range ranges {
+		for _, segment := range f.Image(r) {
+			res = append(res, segment.Destination)
+		}
+	}
+
+	slices.SortFunc(res, func(a, b Range) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+
+	return res, nil
+}
+
+func parseSeeds(line parse.Field) ([]int, error) {
+	numbers, err := line.TrimPrefix("seeds: ")
+	if err != nil {
+		return nil, err
+	}
+
+	return numbers.Ints()
+}
+
+// seedRanges interprets seeds as pairs of range start and length.
+func seedRanges(seeds []int) ([]Range, error) {
+	if len(seeds)%2 != 0 {
+		return nil, fmt.Errorf("odd amount of seed numbers %d", len(seeds))
+	}
+
+	var res []Range
+	for i := 0; i < len(seeds); i += 2 {
+		res = append(res, Range{
+			Start: seeds[i],
+			End:   seeds[i] + seeds[i+1],
+		})
+	}
+
+	slices.SortFunc(res, func(a, b Range) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+
+	return res, nil
+}
+
+// Range of numbers [Start, End). In maps and functions, Value is added to numbers in it.
+type Range struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+	Value int `json:"value,omitempty"`
+}
+
+func parseMap(scanner *parse.Scanner) ([]Range, error) {
+	var res []Range
+
+	for scanner.Scan() {
+		line := scanner.Field()
+		if line.Text == "" {
+			break
+		}
+
+		numbers, err := line.Ints()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(numbers) != 3 {
+			return nil, line.Errorf("expected destination, source and length, got %q", line.Text)
+		}
+
+		destination, start, length := numbers[0], numbers[1], numbers[2]
+		if length < 0 {
+			return nil, line.Errorf("negative length %d", length)
+		}
+
+		res = append(res, Range{
+			Start: start,
+			End:   start + length,
+			Value: destination - start,
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan: %w", err)
+	}
+
+	slices.SortFunc(res, func(a, b Range) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+
+	for i := 1; i < len(res); i++ {
+		if res[i].Start < res[i-1].End {
+			return nil, fmt.Errorf("source ranges starting at %d and %d overlap", res[i-1].Start, res[i].Start)
+		}
+	}
+
+	return res, nil
+}
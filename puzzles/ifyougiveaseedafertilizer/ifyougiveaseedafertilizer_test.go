@@ -0,0 +1,240 @@
+package ifyougiveaseedafertilizer
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/rand"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/internal/puzzletest"
+)
+
+func TestExamples(t *testing.T) {
+	puzzletest.Run(t, []puzzletest.Case{
+		{
+			Name:  "part 1",
+			Solve: Part1,
+			Input: "testdata/example.txt",
+			Want:  35,
+		},
+		{
+			Name:  "part 2",
+			Solve: Part2,
+			Input: "testdata/example.txt",
+			Want:  46,
+		},
+	})
+}
+
+func BenchmarkParse(b *testing.B) {
+	puzzletest.Benchmark(b, 5, "testdata/example.txt", ParseAlmanac)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	puzzletest.Benchmark(b, 5, "testdata/example.txt", Part1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	puzzletest.Benchmark(b, 5, "testdata/example.txt", Part2)
+}
+
+func TestPath(t *testing.T) {
+	input, err := os.ReadFile("testdata/example.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	almanac, err := ParseAlmanac(bytes.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path, err := almanac.Path("seed", "humidity")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var categories []string
+	for _, m := range path {
+		categories = append(categories, m.Destination)
+	}
+
+	if got := strings.Join(categories, " "); got != "soil fertilizer water light temperature humidity" {
+		t.Errorf("got path to %s", got)
+	}
+
+	// Seed 79 has soil 81, fertilizer 81, water 81 and light 74, see the puzzle.
+	lights, err := almanac.Convert("seed", "light", []Range{{Start: 79, End: 80}})
+	if err != nil || len(lights) != 1 || lights[0].Start != 74 {
+		t.Errorf("got lights %v, %v, want 74", lights, err)
+	}
+
+	if _, err := almanac.Path("location", "seed"); err == nil {
+		t.Errorf("got a path against the maps")
+	}
+}
+
+func TestBrokenChain(t *testing.T) {
+	cases := []struct {
+		input string
+		err   string
+	}{
+		{
+			input: "seeds: 1\n\nseed-to-soil map:\n1 2 3\n\nfertilizer-to-location map:\n1 2 3\n",
+			err:   "the chain breaks at soil",
+		},
+		{
+			input: "seeds: 1\n\nseed-to-location map:\n1 2 3\n\nseed-to-location map:\n",
+			err:   "6:1: seed-to-location map is repeated, first seen on line 3",
+		},
+		{
+			input: "seeds: 1\n\nseed-to-seed map:\n",
+			err:   "3:9: seed map to itself",
+		},
+		{
+			input: "seeds: 1\n\nseed to location map:\n",
+			err:   `3:1: expected "-to-"`,
+		},
+	}
+
+	for _, c := range cases {
+		_, err := ParseAlmanac(strings.NewReader(c.input))
+		if err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Errorf("%q: got error %v, want %q", c.input, err, c.err)
+		}
+	}
+
+	// Maps may come in any order.
+	almanac, err := ParseAlmanac(strings.NewReader("seeds: 5\n\nsoil-to-location map:\n100 0 10\n\nseed-to-soil map:\n0 5 1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report, err := explain(almanac, []Range{{Start: 5, End: 6}}); err != nil || report.Lowest != 100 {
+		t.Errorf("got location %d, %v, want 100", report.Lowest, err)
+	}
+}
+
+// TestFunction checks the composed function and its preimages against applying maps one by one.
+func TestFunction(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	randomMap := func() Function {
+		var pieces []Range
+		for start := rng.Intn(10); start < 60; {
+			end := start + 1 + rng.Intn(10)
+			pieces = append(pieces, Range{Start: start, End: end, Value: rng.Intn(41) - 20})
+			start = end + rng.Intn(5)
+		}
+
+		return pieces
+	}
+
+	for i := 0; i < 200; i++ {
+		maps := []Function{randomMap(), randomMap(), randomMap()}
+
+		var f Function
+		for _, m := range maps {
+			f = Compose(f, m)
+		}
+
+		apply := func(n int) int {
+			for _, m := range maps {
+				for _, piece := range m {
+					if piece.Start <= n && n < piece.End {
+						n += piece.Value
+						break
+					}
+				}
+			}
+
+			return n
+		}
+
+		target := Range{Start: rng.Intn(60), End: 0}
+		target.End = target.Start + 1 + rng.Intn(20)
+
+		var want []int
+		for n := -100; n < 200; n++ {
+			if got := f.Apply(n); got != apply(n) {
+				t.Fatalf("%v: got %d for %d, want %d", maps, got, n, apply(n))
+			}
+
+			if m := apply(n); target.Start <= m && m < target.End {
+				want = append(want, n)
+			}
+		}
+
+		var got []int
+		for _, r := range f.Preimage(target) {
+			for n := r.Start; n < r.End; n++ {
+				got = append(got, n)
+			}
+		}
+
+		if !slices.Equal(got, want) {
+			t.Fatalf("%v: got preimage %v of %v, want %v", maps, got, target, want)
+		}
+	}
+}
+
+func TestExplain(t *testing.T) {
+	input, err := os.ReadFile("testdata/example.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := Part2Report(bytes.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Seed 82 goes to location 46, see the puzzle.
+	lowest := report.Segments[0]
+	if lowest.Destination.Start != 46 || lowest.Source.Start != 82 {
+		t.Errorf("got lowest segment %+v, want seed 82 at location 46", lowest)
+	}
+
+	almanac, err := ParseAlmanac(bytes.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := almanac.Function(Seed, Location)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Locations of seeds 79, 14, 55 and 13 are 82, 43, 86 and 35.
+	for seed, location := range map[int]int{79: 82, 14: 43, 55: 86, 13: 35} {
+		if got := f.Apply(seed); got != location {
+			t.Errorf("got location %d of seed %d, want %d", got, seed, location)
+		}
+
+		if got := f.Preimage(Range{Start: location, End: location + 1}); !slices.ContainsFunc(got, func(r Range) bool {
+			return r.Start <= seed && seed < r.End
+		}) {
+			t.Errorf("got seeds %v landing in location %d, want %d among them", got, location, seed)
+		}
+	}
+
+	// Of seeds 79-92 and 55-67, only 82 lands in locations below 47.
+	seeds := f.Origins([]Range{{Start: 79, End: 93}, {Start: 55, End: 68}}, Range{Start: 0, End: 47})
+	if want := []Range{{Start: 82, End: 83}}; !slices.Equal(seeds, want) {
+		t.Errorf("got seeds %v landing in locations below 47, want %v", seeds, want)
+	}
+}
+
+func TestSegmentJSON(t *testing.T) {
+	data, err := json.Marshal(Segment{Source: Range{Start: 1, End: 3}, Destination: Range{Start: 5, End: 7}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := `{"source":{"start":1,"end":3},"destination":{"start":5,"end":7}}`; string(data) != want {
+		t.Errorf("got %s, want %s", data, want)
+	}
+}
@@ -0,0 +1,205 @@
+// Package lines processes puzzle inputs line by line on several goroutines.
+package lines
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+)
+
+// Options of splitting the work. Zero values pick the defaults.
+type Options struct {
+	Workers   int // goroutines processing lines, GOMAXPROCS by default
+	ChunkSize int // bytes read at once, 1 MiB by default; a chunk is extended to the end of its last line
+}
+
+const defaultChunkSize = 1 << 20
+
+type chunk[T any] struct {
+	line int // number of the first line
+	data []byte
+	done chan<- result[T]
+}
+
+type result[T any] struct {
+	values []T
+	err    error
+}
+
+// Process splits the input into chunks at line ends and calls fn on every line on worker goroutines. Results are
+// passed to collect on the calling goroutine in the order of lines, so that it gets the same values as if the lines
+// were processed one by one. Processing stops at the first error, and the error of the earliest line is returned.
+//
+// Lines are split like bufio.ScanLines does, dropping "\r" before "\n".
+func Process[T any](input io.Reader, options Options, fn func(line parse.Field) (T, error), collect func(value T) error) error {
+	workers := options.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	chunkSize := options.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = defaultChunkSize
+	}
+
+	jobs := make(chan chunk[T])
+	order := make(chan chan result[T], 2*workers)
+	stop := make(chan struct{})
+
+	var wg sync.WaitGroup
+
+	// Close stop first, so that the reader and workers exit before returning.
+	defer wg.Wait()
+	defer close(stop)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(order)
+		defer close(jobs)
+
+		split(input, chunkSize, jobs, order, stop)
+	}()
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for c := range jobs {
+				c.done <- processChunk(c, fn, stop)
+			}
+		}()
+	}
+
+	for done := range order {
+		r := <-done
+
+		for _, value := range r.values {
+			if err := collect(value); err != nil {
+				return err
+			}
+		}
+
+		if r.err != nil {
+			return r.err
+		}
+	}
+
+	return nil
+}
+
+// split reads chunks of whole lines, handing them to workers and their results to the collector in order.
+func split[T any](input io.Reader, chunkSize int, jobs chan<- chunk[T], order chan<- chan result[T], stop <-chan struct{}) {
+	line := 1
+	var carry []byte // start of a line not ended in the previous chunk
+
+	for {
+		data := make([]byte, len(carry), max(chunkSize, 2*len(carry)))
+		copy(data, carry)
+
+		n, err := io.ReadFull(input, data[len(carry):cap(data)])
+		data = data[:len(carry)+n]
+
+		eof := errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+		if err != nil && !eof {
+			done := make(chan result[T], 1)
+			done <- result[T]{err: fmt.Errorf("read: %w", err)}
+
+			select {
+			case order <- done:
+			case <-stop:
+			}
+
+			return
+		}
+
+		carry = nil
+		if !eof {
+			end := bytes.LastIndexByte(data, '\n') + 1
+			if end == 0 {
+				// A line longer than the chunk, read on until it ends.
+				carry = data
+				continue
+			}
+
+			data, carry = data[:end], data[end:]
+		}
+
+		if len(data) > 0 {
+			done := make(chan result[T], 1)
+
+			select {
+			case order <- done:
+			case <-stop:
+				return
+			}
+
+			select {
+			case jobs <- chunk[T]{line: line, data: data, done: done}:
+			case <-stop:
+				return
+			}
+
+			line += bytes.Count(data, []byte{'\n'})
+		}
+
+		if eof {
+			return
+		}
+	}
+}
+
+// processChunk calls fn on lines of the chunk. A panic in fn becomes the error of its line, as a worker can't be
+// recovered by the caller of Process.
+func processChunk[T any](c chunk[T], fn func(line parse.Field) (T, error), stop <-chan struct{}) (r result[T]) {
+	i := c.line
+
+	defer func() {
+		if p := recover(); p != nil {
+			r.err = fmt.Errorf("line %d: panic: %v", i, p)
+		}
+	}()
+
+	data := c.data
+	for ; len(data) > 0; i++ {
+		select {
+		case <-stop:
+			return r
+		default:
+		}
+
+		text, rest, _ := bytes.Cut(data, []byte{'\n'})
+		data = rest
+
+		value, err := fn(parse.Field{
+			Text:   string(bytes.TrimSuffix(text, []byte{'\r'})),
+			Line:   i,
+			Column: 1,
+		})
+		if err != nil {
+			r.err = err
+			return r
+		}
+
+		r.values = append(r.values, value)
+	}
+
+	return r
+}
+
+// Sum adds up values of lines, see Process.
+func Sum(input io.Reader, options Options, value func(line parse.Field) (int, error)) (int, error) {
+	sum := 0
+	err := Process(input, options, value, func(v int) error {
+		sum += v
+		return nil
+	})
+
+	return sum, err
+}
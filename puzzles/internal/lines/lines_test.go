@@ -0,0 +1,130 @@
+package lines
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+)
+
+// scanLines is the sequential reference: every line with its number, as parse.Scanner sees them.
+func scanLines(input string) []string {
+	var lines []string
+
+	scanner := parse.NewScanner(strings.NewReader(input))
+	for scanner.Scan() {
+		lines = append(lines, fmt.Sprintf("%d:%s", scanner.Line(), scanner.Text()))
+	}
+
+	return lines
+}
+
+func TestProcessKeepsOrder(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 200; i++ {
+		var b strings.Builder
+		for j := rng.Intn(50); j > 0; j-- {
+			b.WriteString(strings.Repeat("x", rng.Intn(30)))
+			if rng.Intn(5) == 0 {
+				b.WriteString("\r")
+			}
+			b.WriteString("\n")
+		}
+		if rng.Intn(2) == 0 {
+			b.WriteString("last")
+		}
+
+		input := b.String()
+		options := Options{Workers: 1 + rng.Intn(4), ChunkSize: 1 + rng.Intn(64)}
+
+		var got []string
+		err := Process(strings.NewReader(input), options, func(line parse.Field) (string, error) {
+			return fmt.Sprintf("%d:%s", line.Line, line.Text), nil
+		}, func(line string) error {
+			got = append(got, line)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("process: %v", err)
+		}
+
+		if want := scanLines(input); fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Fatalf("%q with %+v: got %q, want %q", input, options, got, want)
+		}
+	}
+}
+
+func TestProcessReturnsFirstError(t *testing.T) {
+	input := strings.Repeat("1\n", 1000) + "x\n" + strings.Repeat("1\n", 1000) + "y\n"
+
+	collected := 0
+	err := Process(strings.NewReader(input), Options{Workers: 8, ChunkSize: 16}, func(line parse.Field) (int, error) {
+		return line.Int()
+	}, func(int) error {
+		collected++
+		return nil
+	})
+
+	var parseErr *parse.Error
+	if !errors.As(err, &parseErr) || parseErr.Line != 1001 {
+		t.Errorf("got error %v, want one at line 1001", err)
+	}
+
+	if collected != 1000 {
+		t.Errorf("collected %d values, want 1000 before the error", collected)
+	}
+
+	stop := errors.New("stop")
+	err = Process(strings.NewReader(input), Options{ChunkSize: 16}, func(line parse.Field) (string, error) {
+		return line.Text, nil
+	}, func(string) error {
+		return stop
+	})
+	if !errors.Is(err, stop) {
+		t.Errorf("got error %v, want the one of collect", err)
+	}
+}
+
+func TestProcessRecoversPanic(t *testing.T) {
+	input := strings.Repeat("1\n", 100) + "panic\n" + strings.Repeat("1\n", 100)
+
+	collected := 0
+	err := Process(strings.NewReader(input), Options{Workers: 4, ChunkSize: 16}, func(line parse.Field) (int, error) {
+		if line.Text == "panic" {
+			var counts []int
+			return counts[line.Line], nil
+		}
+
+		return line.Int()
+	}, func(int) error {
+		collected++
+		return nil
+	})
+	if err == nil || !strings.HasPrefix(err.Error(), "line 101: panic: ") {
+		t.Errorf("got error %v, want a panic at line 101", err)
+	}
+
+	if collected != 100 {
+		t.Errorf("collected %d values, want 100 before the panic", collected)
+	}
+}
+
+func TestSum(t *testing.T) {
+	var b strings.Builder
+	want := 0
+	for i := 0; i < 100000; i++ {
+		fmt.Fprintln(&b, i)
+		want += i
+	}
+
+	got, err := Sum(strings.NewReader(b.String()), Options{ChunkSize: 4096}, func(line parse.Field) (int, error) {
+		return line.Int()
+	})
+	if err != nil || got != want {
+		t.Errorf("got %d, %v, want %d", got, err, want)
+	}
+}
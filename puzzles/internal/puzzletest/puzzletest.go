@@ -0,0 +1,85 @@
+// Package puzzletest runs solvers against example inputs in tests.
+package puzzletest
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/harmlessevil/advent-of-code-2023/client"
+)
+
+type Case struct {
+	Name  string
+	Solve func(input io.Reader) (int, error)
+	Input string // path to the input file, usually under testdata
+	Want  int
+}
+
+// Run runs every case as a subtest.
+func Run(t *testing.T, cases []Case) {
+	t.Helper()
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Solve(t, c.Solve, c.Input); got != c.Want {
+				t.Errorf("got %d, want %d", got, c.Want)
+			}
+		})
+	}
+}
+
+// Solve runs the solver on the input file and returns the answer.
+func Solve(t *testing.T, solve func(input io.Reader) (int, error), path string) int {
+	t.Helper()
+
+	input, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open input: %v", err)
+	}
+	defer input.Close()
+
+	answer, err := solve(input)
+	if err != nil {
+		t.Fatalf("solve: %v", err)
+	}
+
+	return answer
+}
+
+// Benchmark runs the function b.N times on the input of the day. It's the real input if it's fetched already, see package
+// client, or the example otherwise. The input is read once, outside of the measurement.
+func Benchmark[T any](b *testing.B, day int, example string, run func(input io.Reader) (T, error)) {
+	b.Helper()
+
+	path := example
+	if config, err := client.LoadConfig(); err == nil {
+		if cached := config.CachedInputPath(day); fileExists(cached) {
+			path = cached
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		b.Fatalf("read input: %v", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := run(bytes.NewReader(data)); err != nil {
+			b.Fatalf("run on %s: %v", path, err)
+		}
+	}
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,156 @@
+// Package lavaductlagoon solves day 18 of Advent of Code 2023, "Lavaduct Lagoon".
+package lavaductlagoon
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/harmlessevil/advent-of-code-2023/grid"
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+)
+
+type Polygon struct {
+	Perimeter int
+	Points    []grid.Point
+}
+
+type Instruction struct {
+	Direction grid.Direction
+	Distance  int
+}
+
+func Part1(input io.Reader) (int, error) {
+	return solve(input, ParsePlanInstruction)
+}
+
+func Part2(input io.Reader) (int, error) {
+	return solve(input, ParseColorInstruction)
+}
+
+func solve(input io.Reader, parseInstruction func(line parse.Field) (Instruction, error)) (int, error) {
+	polygon, err := ParsePolygon(input, parseInstruction)
+	if err != nil {
+		return 0, fmt.Errorf("parse polygon: %w", err)
+	}
+
+	return polygon.Area() + polygon.Perimeter/2 + 1, nil
+}
+
+func ParsePolygon(r io.Reader, parseInstruction func(line parse.Field) (Instruction, error)) (Polygon, error) {
+	var polygon Polygon
+	var current grid.Point
+
+	scanner := parse.NewScanner(r)
+
+	for scanner.Scan() {
+		instruction, err := parseInstruction(scanner.Field())
+		if err != nil {
+			return Polygon{}, err
+		}
+
+		polygon.Points = append(polygon.Points, current)
+		polygon.Perimeter += instruction.Distance
+
+		current = current.Add(instruction.Direction.ToVector().Mul(instruction.Distance))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return Polygon{}, fmt.Errorf("scan: %w", err)
+	}
+
+	return polygon, nil
+}
+
+var letterToDirection = map[string]grid.Direction{
+	"R": grid.DirectionRight,
+	"D": grid.DirectionDown,
+	"L": grid.DirectionLeft,
+	"U": grid.DirectionUp,
+}
+
+// splitPlanLine splits the line of the dig plan, like "R 6 (#70c710)", into the direction, the distance and the color
+// digits.
+func splitPlanLine(line parse.Field) (direction, distance, color parse.Field, err error) {
+	fields := line.Fields()
+	if len(fields) != 3 {
+		return parse.Field{}, parse.Field{}, parse.Field{}, line.Errorf("expected direction, distance and color, got %q", line.Text)
+	}
+
+	color, err = fields[2].TrimPrefix("(#")
+	if err != nil {
+		return parse.Field{}, parse.Field{}, parse.Field{}, err
+	}
+
+	color, err = color.TrimSuffix(")")
+	if err != nil {
+		return parse.Field{}, parse.Field{}, parse.Field{}, err
+	}
+
+	if len(color.Text) != 6 {
+		return parse.Field{}, parse.Field{}, parse.Field{}, color.Errorf("expected 6 hexadecimal digits, got %q", color.Text)
+	}
+
+	return fields[0], fields[1], color, nil
+}
+
+// ParsePlanInstruction reads the direction and the distance from the dig plan, ignoring the color.
+func ParsePlanInstruction(line parse.Field) (Instruction, error) {
+	directionText, distanceText, _, err := splitPlanLine(line)
+	if err != nil {
+		return Instruction{}, err
+	}
+
+	direction, ok := letterToDirection[directionText.Text]
+	if !ok {
+		return Instruction{}, directionText.Errorf("unknown direction %q", directionText.Text)
+	}
+
+	distance, err := distanceText.Int()
+	if err != nil {
+		return Instruction{}, err
+	}
+
+	return Instruction{
+		Direction: direction,
+		Distance:  distance,
+	}, nil
+}
+
+// ParseColorInstruction reads the instruction hidden in the color: five hexadecimal digits of the distance followed by
+// the direction.
+func ParseColorInstruction(line parse.Field) (Instruction, error) {
+	_, _, color, err := splitPlanLine(line)
+	if err != nil {
+		return Instruction{}, err
+	}
+
+	distance, err := strconv.ParseInt(color.Text[:5], 16, 0)
+	if err != nil {
+		return Instruction{}, color.Errorf("expected hexadecimal distance, got %q", color.Text[:5])
+	}
+
+	direction := color.Text[5] - '0'
+	if direction > byte(grid.DirectionUp) {
+		return Instruction{}, parse.Errorf(color.Line, color.Column+5, "unknown direction %q", color.Text[5])
+	}
+
+	return Instruction{
+		Direction: grid.Direction(direction),
+		Distance:  int(distance),
+	}, nil
+}
+
+func (p Polygon) Area() int {
+	area := 0
+
+	for i := 1; i < len(p.Points); i++ {
+		area += p.Points[i-1].X*p.Points[i].Y - p.Points[i-1].Y*p.Points[i].X
+	}
+
+	if area < 0 {
+		area = -area
+	}
+
+	return area / 2
+}
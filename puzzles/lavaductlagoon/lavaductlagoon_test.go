@@ -0,0 +1,39 @@
+package lavaductlagoon
+
+import (
+	"io"
+	"testing"
+
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/internal/puzzletest"
+)
+
+func TestExamples(t *testing.T) {
+	puzzletest.Run(t, []puzzletest.Case{
+		{
+			Name:  "part 1",
+			Solve: Part1,
+			Input: "testdata/example.txt",
+			Want:  62,
+		},
+		{
+			Name:  "part 2",
+			Solve: Part2,
+			Input: "testdata/example.txt",
+			Want:  952408144115,
+		},
+	})
+}
+
+func BenchmarkParse(b *testing.B) {
+	puzzletest.Benchmark(b, 18, "testdata/example.txt", func(input io.Reader) (Polygon, error) {
+		return ParsePolygon(input, ParsePlanInstruction)
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	puzzletest.Benchmark(b, 18, "testdata/example.txt", Part1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	puzzletest.Benchmark(b, 18, "testdata/example.txt", Part2)
+}
@@ -0,0 +1,278 @@
+// Package lenslibrary solves day 15 of Advent of Code 2023, "Lens Library".
+package lenslibrary
+
+import (
+	"bufio"
+	"bytes"
+	"container/list"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+)
+
+type Hash struct {
+	State byte
+}
+
+func (h *Hash) Write(p []byte) (n int, err error) {
+	for _, symbol := range p {
+		h.State = byte((int(h.State) + int(symbol)) * 17)
+	}
+
+	return len(p), nil
+}
+
+func (h *Hash) Sum64() uint64 {
+	return uint64(h.State)
+}
+
+func (h *Hash) Sum(b []byte) []byte {
+	return append(b, h.State)
+}
+
+func (h *Hash) Reset() {
+	h.State = 0
+}
+
+func (h *Hash) Size() int {
+	return 1
+}
+
+func (h *Hash) BlockSize() int {
+	return 1
+}
+
+type HashMap struct {
+	Buckets [256]list.List
+}
+
+type MapEntry struct {
+	Key   []byte
+	Value int
+}
+
+func (m *HashMap) bucketIndex(key []byte) uint64 {
+	var hash Hash
+	_, _ = hash.Write(key)
+
+	return hash.Sum64()
+}
+
+func (m *HashMap) find(bucket *list.List, key []byte) *list.Element {
+	for e := bucket.Front(); e != nil; e = e.Next() {
+		mapEntry := e.Value.(*MapEntry)
+		if slices.Equal(mapEntry.Key, key) {
+			return e
+		}
+	}
+
+	return nil
+}
+
+func (m *HashMap) Set(key []byte, value int) {
+	bucket := &m.Buckets[m.bucketIndex(key)]
+
+	if e := m.find(bucket, key); e != nil {
+		mapEntry := e.Value.(*MapEntry)
+		mapEntry.Value = value
+	} else {
+		bucket.PushBack(&MapEntry{
+			Key:   append([]byte{}, key...),
+			Value: value,
+		})
+	}
+}
+
+func (m *HashMap) Delete(key []byte) {
+	bucket := &m.Buckets[m.bucketIndex(key)]
+
+	if e := m.find(bucket, key); e != nil {
+		bucket.Remove(e)
+	}
+}
+
+func Part1(input io.Reader) (int, error) {
+	steps, err := ParseSteps(input)
+	if err != nil {
+		return 0, fmt.Errorf("parse steps: %w", err)
+	}
+
+	sum := 0
+
+	for _, step := range steps {
+		var hash Hash
+		_, _ = hash.Write(step)
+
+		sum += int(hash.Sum64())
+	}
+
+	return sum, nil
+}
+
+// Report is the focusing power and the lenses in every box which isn't empty.
+type Report struct {
+	FocusingPower int   `json:"focusing_power"`
+	Boxes         []Box `json:"boxes"`
+}
+
+func (r Report) Answer() int {
+	return r.FocusingPower
+}
+
+type Box struct {
+	Index  int    `json:"index"`
+	Lenses []Lens `json:"lenses"`
+}
+
+type Lens struct {
+	Label       string `json:"label"`
+	FocalLength int    `json:"focal_length"`
+}
+
+func Part2(input io.Reader) (int, error) {
+	steps, err := ParseSteps(input)
+	if err != nil {
+		return 0, fmt.Errorf("parse steps: %w", err)
+	}
+
+	hashMap := initializeHashMap(steps)
+
+	power := 0
+	for i := range hashMap.Buckets {
+		for e, j := hashMap.Buckets[i].Front(), 0; e != nil; e, j = e.Next(), j+1 {
+			power += (i + 1) * (j + 1) * e.Value.(*MapEntry).Value
+		}
+	}
+
+	return power, nil
+}
+
+func Part2Report(input io.Reader) (Report, error) {
+	steps, err := ParseSteps(input)
+	if err != nil {
+		return Report{}, fmt.Errorf("parse steps: %w", err)
+	}
+
+	hashMap := initializeHashMap(steps)
+
+	var report Report
+
+	for i, bucket := range hashMap.Buckets {
+		if bucket.Len() == 0 {
+			continue
+		}
+
+		box := Box{Index: i}
+		for e, j := bucket.Front(), 0; e != nil; e, j = e.Next(), j+1 {
+			mapEntry := e.Value.(*MapEntry)
+			box.Lenses = append(box.Lenses, Lens{
+				Label:       string(mapEntry.Key),
+				FocalLength: mapEntry.Value,
+			})
+
+			report.FocusingPower += (i + 1) * (j + 1) * mapEntry.Value
+		}
+
+		slog.Debug("box", slog.Int("index", i), slog.Any("lenses", box.Lenses))
+
+		report.Boxes = append(report.Boxes, box)
+	}
+
+	return report, nil
+}
+
+func ParseSteps(r io.Reader) ([][]byte, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		if i := bytes.IndexRune(data, ','); i != -1 {
+			return i + 1, data[:i], nil
+		}
+
+		if !atEOF {
+			return 0, nil, nil
+		}
+
+		return 0, bytes.TrimRight(data, "\r\n"), bufio.ErrFinalToken
+	})
+
+	var steps [][]byte
+
+	for column := 1; scanner.Scan(); column += len(scanner.Bytes()) + 1 {
+		step := scanner.Bytes()
+		if err := validateStep(step); err != nil {
+			return nil, parse.Errorf(1, column, "step %q: %w", step, err)
+		}
+
+		steps = append(steps, bytes.Clone(step))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan: %w", err)
+	}
+
+	return steps, nil
+}
+
+// validateStep checks that the step either removes a lens, like "cm-", or puts one, like "rn=1".
+func validateStep(step []byte) error {
+	if len(step) == 0 {
+		return fmt.Errorf("empty step")
+	}
+
+	label, i := parseLabel(step)
+	if len(label) == 0 {
+		return fmt.Errorf("expected a label")
+	}
+
+	switch {
+	case step[i] == '-' && i == len(step)-1:
+		return nil
+	case step[i] == '=' && i < len(step)-1:
+		for _, digit := range step[i+1:] {
+			if digit < '0' || digit > '9' {
+				return fmt.Errorf("expected a focal length")
+			}
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("expected an operation")
+}
+
+func initializeHashMap(steps [][]byte) HashMap {
+	var hashMap HashMap
+
+	for _, step := range steps {
+		label, i := parseLabel(step)
+		switch step[i] {
+		case '=':
+			hashMap.Set(label, parseInt(step[i+1:]))
+		case '-':
+			hashMap.Delete(label)
+		}
+	}
+
+	return hashMap
+}
+
+func parseLabel(line []byte) ([]byte, int) {
+	i := bytes.IndexRune(line, '=')
+	if i == -1 {
+		i = len(line) - 1
+	}
+
+	return line[:i], i
+}
+
+func parseInt(data []byte) int {
+	res := 0
+	for _, item := range data {
+		res = res*10 + int(item) - '0'
+	}
+
+	return res
+}
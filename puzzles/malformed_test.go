@@ -0,0 +1,93 @@
+package puzzles
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+)
+
+func TestMalformedInput(t *testing.T) {
+	cases := []struct {
+		day    int
+		input  string
+		line   int
+		column int
+	}{
+		{day: 1, input: "1abc2\npqrstuvwx\n", line: 2, column: 0},
+		{day: 2, input: "Game 1: 3 blue, 4\n", line: 1, column: 17},
+		{day: 4, input: "Card 1: 41 48 | 83 x6\n", line: 1, column: 20},
+		{day: 5, input: "seeds: 79 14\n\nseed-to-soil map:\n50 98\n", line: 4, column: 1},
+		{day: 6, input: "Time: 7 15\nDistance: 9 x\n", line: 2, column: 13},
+		{day: 7, input: "32T3K 765\nT55J5\n", line: 2, column: 1},
+		{day: 7, input: "32T3K 765\nT55X5 684\n", line: 2, column: 4},
+		{day: 8, input: "LR\n\nAAA = (BBB, BBB)\nBBB = (AAA\n", line: 4, column: 8},
+		{day: 8, input: "LR\n\nAAA = (BBB, CCC)\nBBB = (AAA, AAA)\n", line: 3, column: 13},
+		{day: 9, input: "0 3 6 9 1x\n", line: 1, column: 9},
+		{day: 10, input: ".....\n.S-7.\n.|.|.\n.L-J.\n....\n", line: 5, column: 0},
+		{day: 12, input: "???.### 1,1,x\n", line: 1, column: 13},
+		{day: 12, input: "???.### -1,1,3\n", line: 1, column: 9},
+		{day: 12, input: "???.### 1,0,3\n", line: 1, column: 11},
+		{day: 15, input: "rn=1,cm-,qp=x\n", line: 1, column: 10},
+		{day: 18, input: "R 6 (#70c710)\nD 5 (#0dc5\n", line: 2, column: 7},
+		{day: 18, input: "R 6 (#70c710)\nX 5 (#0dc571)\n", line: 2, column: 1},
+		{day: 19, input: "in{s<1351:px,qqz}\n", line: 1, column: 11},
+		{day: 19, input: "in{x>3000:A,R}\n\n{x=5000,m=1,a=1,s=1}\n", line: 3, column: 4},
+		{day: 20, input: "broadcaster -> a\n%a b\n", line: 2, column: 1},
+		{day: 22, input: "1,0,1~1,2,1\n0,0,2~2,0\n", line: 2, column: 7},
+		{day: 24, input: "19, 13, 30 @ -2, 1\n", line: 1, column: 13},
+		{day: 25, input: "jqt: rhn xhk\nrsh frs\n", line: 2, column: 1},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("day %d", c.day), func(t *testing.T) {
+			_, err := solve(t, c.day, 1, c.input)
+
+			var parseErr *parse.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("got error %v, want a parse error", err)
+			}
+
+			if parseErr.Line != c.line || parseErr.Column != c.column {
+				t.Errorf("got error at %d:%d, want %d:%d: %v", parseErr.Line, parseErr.Column, c.line, c.column, err)
+			}
+		})
+	}
+}
+
+// TestGarbageInput checks that no solver panics on input that has nothing to do with the puzzle.
+func TestGarbageInput(t *testing.T) {
+	inputs := []string{"", "\n", "?\n", "1 2 3\n", "x: y\n"}
+
+	for _, puzzle := range All() {
+		for part := 1; part <= 2; part++ {
+			if _, err := puzzle.Part(part); err != nil {
+				continue
+			}
+
+			for _, input := range inputs {
+				_, _ = solve(t, puzzle.Day, part, input)
+			}
+		}
+	}
+}
+
+func solve(t *testing.T, day, part int, input string) (Result, error) {
+	t.Helper()
+
+	puzzle, err := Lookup(day)
+	if err != nil {
+		t.Fatalf("lookup: %v", err)
+	}
+
+	solver, err := puzzle.Part(part)
+	if err != nil {
+		t.Fatalf("day %d: %v", day, err)
+	}
+
+	return solver.Solve(strings.NewReader(input))
+}
@@ -0,0 +1,136 @@
+// Package miragemaintenance solves day 9 of Advent of Code 2023, "Mirage Maintenance".
+package miragemaintenance
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+
+	"github.com/harmlessevil/advent-of-code-2023/numtheory"
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/internal/lines"
+)
+
+// Report lists the extrapolated value of every history.
+type Report struct {
+	Sum       int              `json:"sum"`
+	Sequences []SequenceReport `json:"sequences"`
+}
+
+func (r Report) Answer() int {
+	return r.Sum
+}
+
+type SequenceReport struct {
+	Degree      int      `json:"degree"`
+	ReachesZero bool     `json:"reaches_zero"`
+	Value       *big.Int `json:"value"`
+}
+
+func Part1(input io.Reader) (int, error) {
+	return Extrapolate(input, 1, nil)
+}
+
+func Part2(input io.Reader) (int, error) {
+	return Extrapolate(input, -1, nil)
+}
+
+func Part1Report(input io.Reader) (Report, error) {
+	return extrapolateReport(input, 1)
+}
+
+func Part2Report(input io.Reader) (Report, error) {
+	return extrapolateReport(input, -1)
+}
+
+func extrapolateReport(input io.Reader, steps int) (Report, error) {
+	var report Report
+
+	sum, err := Extrapolate(input, steps, func(sequence Sequence, value *big.Int) {
+		report.Sequences = append(report.Sequences, SequenceReport{
+			Degree:      sequence.Degree(),
+			ReachesZero: sequence.ReachesZero,
+			Value:       value,
+		})
+	})
+	if err != nil {
+		return Report{}, err
+	}
+
+	report.Sum = sum
+
+	return report, nil
+}
+
+// Extrapolate adds up values of every history the steps after its last value, or before its first one if steps are
+// negative, passing every sequence with its value to visit if it's not nil. Histories are fitted on several
+// goroutines.
+func Extrapolate(input io.Reader, steps int, visit func(Sequence, *big.Int)) (int, error) {
+	sum := new(big.Int)
+
+	err := lines.Process(input, lines.Options{}, func(line parse.Field) (Sequence, error) {
+		numbers, err := parseHistory(line)
+		if err != nil {
+			return Sequence{}, fmt.Errorf("parse history: %w", err)
+		}
+
+		return Fit(numbers), nil
+	}, func(sequence Sequence) error {
+		value := sequence.Extrapolate(steps)
+		sum.Add(sum, value)
+
+		if !sequence.ReachesZero {
+			slog.Debug("differences never reach zero", slog.Int("length", sequence.Length), slog.Any("value", value))
+		}
+
+		if visit != nil {
+			visit(sequence, value)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if !sum.IsInt64() {
+		return 0, &numtheory.OverflowError{Op: "sum", Exact: sum}
+	}
+
+	return int(sum.Int64()), nil
+}
+
+func ParseHistories(r io.Reader) ([][]int, error) {
+	var histories [][]int
+
+	scanner := parse.NewScanner(r)
+
+	for scanner.Scan() {
+		numbers, err := parseHistory(scanner.Field())
+		if err != nil {
+			return nil, err
+		}
+
+		histories = append(histories, numbers)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan: %w", err)
+	}
+
+	return histories, nil
+}
+
+func parseHistory(line parse.Field) ([]int, error) {
+	numbers, err := line.Ints()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(numbers) == 0 {
+		return nil, line.Errorf("empty history")
+	}
+
+	return numbers, nil
+}
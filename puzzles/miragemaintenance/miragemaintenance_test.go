@@ -0,0 +1,139 @@
+package miragemaintenance
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/internal/puzzletest"
+)
+
+func TestExamples(t *testing.T) {
+	puzzletest.Run(t, []puzzletest.Case{
+		{
+			Name:  "part 1",
+			Solve: Part1,
+			Input: "testdata/example.txt",
+			Want:  114,
+		},
+		{
+			Name:  "part 2",
+			Solve: Part2,
+			Input: "testdata/example.txt",
+			Want:  2,
+		},
+	})
+}
+
+func BenchmarkParse(b *testing.B) {
+	puzzletest.Benchmark(b, 9, "testdata/example.txt", ParseHistories)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	puzzletest.Benchmark(b, 9, "testdata/example.txt", Part1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	puzzletest.Benchmark(b, 9, "testdata/example.txt", Part2)
+}
+
+func TestSequence(t *testing.T) {
+	cubes := []int{0, 1, 8, 27, 64}
+
+	sequence := Fit(cubes)
+	if sequence.Degree() != 3 || !sequence.ReachesZero {
+		t.Errorf("got degree %d, reaching zero %t, want 3, true", sequence.Degree(), sequence.ReachesZero)
+	}
+
+	for steps, want := range map[int]int64{1: 125, 3: 343, 0: 64, -1: -1, -3: -27} {
+		if got := sequence.Extrapolate(steps); got.Cmp(big.NewInt(want)) != 0 {
+			t.Errorf("%d steps: got %s, want %d", steps, got, want)
+		}
+	}
+
+	for i, n := range cubes {
+		if got := sequence.At(i); got.Int64() != int64(n) {
+			t.Errorf("at %d: got %s, want %d", i, got, n)
+		}
+	}
+
+	if zeros := Fit([]int{0, 0, 0}); zeros.Degree() != -1 || !zeros.ReachesZero || zeros.Extrapolate(-5).Sign() != 0 {
+		t.Errorf("got %+v for zeros", zeros)
+	}
+
+	// Differences of numbers alternating between the limits of int64 don't fit in it, and never reach zero.
+	alternating := Fit([]int{math.MaxInt64, math.MinInt64, math.MaxInt64, math.MinInt64})
+	if alternating.ReachesZero || alternating.Degree() != 3 {
+		t.Errorf("got %+v for alternating numbers", alternating)
+	}
+
+	want, _ := new(big.Int).SetString("-138350580552821637113", 10)
+	if got := alternating.Extrapolate(1); got.Cmp(want) != 0 {
+		t.Errorf("got %s after alternating numbers, want %s", got, want)
+	}
+}
+
+// TestSequenceAgainstDifferences compares several steps of extrapolation with extending rows of differences one value
+// at a time, on random histories of polynomials and of noise.
+func TestSequenceAgainstDifferences(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 200; i++ {
+		history := make([]int, rng.Intn(8)+1)
+		for j := range history {
+			history[j] = rng.Intn(21) - 10
+		}
+
+		if i%2 == 0 {
+			a, b, c := rng.Intn(11)-5, rng.Intn(11)-5, rng.Intn(11)-5
+			for j := range history {
+				history[j] = a*j*j + b*j + c
+			}
+		}
+
+		sequence := Fit(history)
+
+		forwards, backwards := slices.Clone(history), slices.Clone(history)
+		for steps := 1; steps <= 5; steps++ {
+			forwards = append(forwards, extendForwards(forwards[:len(history)+steps-1]))
+			backwards = append([]int{extendBackwards(backwards[:len(history)])}, backwards...)
+
+			if got, want := sequence.Extrapolate(steps), forwards[len(forwards)-1]; got.Int64() != int64(want) {
+				t.Fatalf("%v, %d steps forwards: got %s, want %d", history, steps, got, want)
+			}
+
+			if got, want := sequence.Extrapolate(-steps), backwards[0]; got.Int64() != int64(want) {
+				t.Fatalf("%v, %d steps backwards: got %s, want %d", history, steps, got, want)
+			}
+		}
+	}
+}
+
+// extendForwards extrapolates the next value of the numbers by rows of differences.
+func extendForwards(numbers []int) int {
+	if len(numbers) == 0 {
+		return 0
+	}
+
+	differences := make([]int, len(numbers)-1)
+	for i := range differences {
+		differences[i] = numbers[i+1] - numbers[i]
+	}
+
+	return numbers[len(numbers)-1] + extendForwards(differences)
+}
+
+func extendBackwards(numbers []int) int {
+	if len(numbers) == 0 {
+		return 0
+	}
+
+	differences := make([]int, len(numbers)-1)
+	for i := range differences {
+		differences[i] = numbers[i+1] - numbers[i]
+	}
+
+	return numbers[0] - extendBackwards(differences)
+}
@@ -0,0 +1,82 @@
+package miragemaintenance
+
+import "math/big"
+
+// Sequence is a history fitted with a polynomial, by Newton's forward differences at its first value.
+type Sequence struct {
+	Length       int        // of the history
+	Coefficients []*big.Int // first values of rows of differences, from the history itself to the last non-zero row
+	ReachesZero  bool       // whether a row of differences is all zeros; if not, the polynomial is only a guess
+}
+
+// Fit builds rows of differences of the history until one of them is all zeros, or there is none left.
+func Fit(history []int) Sequence {
+	sequence := Sequence{Length: len(history)}
+
+	row := make([]*big.Int, len(history))
+	for i, n := range history {
+		row[i] = big.NewInt(int64(n))
+	}
+
+	for len(row) > 0 {
+		if allZeros(row) {
+			sequence.ReachesZero = true
+			break
+		}
+
+		sequence.Coefficients = append(sequence.Coefficients, row[0])
+
+		next := make([]*big.Int, len(row)-1)
+		for i := range next {
+			next[i] = new(big.Int).Sub(row[i+1], row[i])
+		}
+
+		row = next
+	}
+
+	return sequence
+}
+
+// Degree of the polynomial, -1 if the history is all zeros.
+func (s Sequence) Degree() int {
+	return len(s.Coefficients) - 1
+}
+
+// At returns the value at the index of the history, which may be past either end of it. By Newton's formula, it's the
+// sum of coefficients multiplied by binomial coefficients C(n, k), which are integers for negative n too.
+func (s Sequence) At(n int) *big.Int {
+	res := new(big.Int)
+	binomial := big.NewInt(1)
+
+	for k, coefficient := range s.Coefficients {
+		if k > 0 {
+			// C(n, k) = C(n, k-1) * (n-k+1) / k, and the division is exact.
+			binomial.Mul(binomial, big.NewInt(int64(n-k+1)))
+			binomial.Quo(binomial, big.NewInt(int64(k)))
+		}
+
+		res.Add(res, new(big.Int).Mul(binomial, coefficient))
+	}
+
+	return res
+}
+
+// Extrapolate returns the value the steps after the last one of the history, or before the first one if steps are
+// negative.
+func (s Sequence) Extrapolate(steps int) *big.Int {
+	if steps < 0 {
+		return s.At(steps)
+	}
+
+	return s.At(s.Length - 1 + steps)
+}
+
+func allZeros(numbers []*big.Int) bool {
+	for _, n := range numbers {
+		if n.Sign() != 0 {
+			return false
+		}
+	}
+
+	return true
+}
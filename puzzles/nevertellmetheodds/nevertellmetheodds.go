@@ -0,0 +1,223 @@
+// Package nevertellmetheodds solves day 24 of Advent of Code 2023, "Never Tell Me The Odds".
+package nevertellmetheodds
+
+import (
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+)
+
+type Point3D struct {
+	X float64
+	Y float64
+	Z float64
+}
+
+func (p Point3D) String() string {
+	return fmt.Sprintf("%.15g, %.15g, %.15g", p.X, p.Y, p.Z)
+}
+
+func (p Point3D) Add(other Point3D) Point3D {
+	return Point3D{
+		X: p.X + other.X,
+		Y: p.Y + other.Y,
+		Z: p.Z + other.Z,
+	}
+}
+
+func (p Point3D) Sub(other Point3D) Point3D {
+	return Point3D{
+		X: p.X - other.X,
+		Y: p.Y - other.Y,
+		Z: p.Z - other.Z,
+	}
+}
+
+func (p Point3D) Dot(other Point3D) float64 {
+	return p.X*other.X + p.Y*other.Y + p.Z*other.Z
+}
+
+func (p Point3D) Mul(scalar float64) Point3D {
+	return Point3D{
+		X: p.X * scalar,
+		Y: p.Y * scalar,
+		Z: p.Z * scalar,
+	}
+}
+
+type Line struct {
+	Start     Point3D
+	Direction Point3D
+}
+
+func (h Line) String() string {
+	return fmt.Sprintf("%s @ %s", h.Start, h.Direction)
+}
+
+type RockPosition struct {
+	X int64
+	Y int64
+	Z int64
+}
+
+// TestArea bounds X and Y coordinates where hailstones' paths are checked for intersections.
+var TestArea = [2]float64{200_000_000_000_000, 400_000_000_000_000}
+
+func Part1(input io.Reader) (int, error) {
+	hailstones, err := ParseHailstones(input)
+	if err != nil {
+		return 0, fmt.Errorf("parse hailstones: %w", err)
+	}
+
+	return countIntersections(hailstones, TestArea), nil
+}
+
+func Part2(input io.Reader) (int, error) {
+	hailstones, err := ParseHailstones(input)
+	if err != nil {
+		return 0, fmt.Errorf("parse hailstones: %w", err)
+	}
+
+	position, err := throwRock(hailstones)
+	if err != nil {
+		return 0, fmt.Errorf("throw rock: %w", err)
+	}
+
+	return int(position.X + position.Y + position.Z), nil
+}
+
+func ParseHailstones(r io.Reader) ([]Line, error) {
+	var hailstones []Line
+
+	scanner := parse.NewScanner(r)
+
+	for scanner.Scan() {
+		hailstone, err := parseHailstone(scanner.Field())
+		if err != nil {
+			return nil, err
+		}
+
+		hailstones = append(hailstones, hailstone)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan: %w", err)
+	}
+
+	return hailstones, nil
+}
+
+// parseHailstone reads the position and the velocity like "19, 13, 30 @ -2, 1, -2".
+func parseHailstone(line parse.Field) (Line, error) {
+	startText, directionText, err := line.Cut("@")
+	if err != nil {
+		return Line{}, err
+	}
+
+	start, err := parsePoint3D(startText)
+	if err != nil {
+		return Line{}, err
+	}
+
+	direction, err := parsePoint3D(directionText)
+	if err != nil {
+		return Line{}, err
+	}
+
+	return Line{
+		Start:     start,
+		Direction: direction,
+	}, nil
+}
+
+func parsePoint3D(field parse.Field) (Point3D, error) {
+	coordinates := field.Split(",")
+	if len(coordinates) != 3 {
+		return Point3D{}, field.Errorf("expected 3 coordinates, got %q", field.Text)
+	}
+
+	var res Point3D
+	for i, p := range [3]*float64{&res.X, &res.Y, &res.Z} {
+		fields := coordinates[i].Fields()
+		if len(fields) != 1 {
+			return Point3D{}, coordinates[i].Errorf("expected a number, got %q", coordinates[i].Text)
+		}
+
+		coordinate, err := fields[0].Int()
+		if err != nil {
+			return Point3D{}, err
+		}
+
+		*p = float64(coordinate)
+	}
+
+	return res, nil
+}
+
+// countIntersections counts pairs of hailstones whose paths cross in the future inside the test area, ignoring the Z axis.
+func countIntersections(hailstones []Line, testArea [2]float64) int {
+	flat := make([]Line, len(hailstones))
+	for i, hailstone := range hailstones {
+		hailstone.Start.Z = 0
+		hailstone.Direction.Z = 0
+
+		flat[i] = hailstone
+	}
+
+	inTestArea := func(v float64) bool {
+		return v >= testArea[0] && v <= testArea[1]
+	}
+
+	count := 0
+
+	for i := range flat {
+		for j := i + 1; j < len(flat); j++ {
+			a, b := intersect(flat[i], flat[j])
+
+			if math.IsNaN(a.Value) || math.IsInf(a.Value, 0) || a.Value < 0 || b.Value < 0 {
+				continue // parallel or crossed in the past
+			}
+
+			if inTestArea(a.Point.X) && inTestArea(a.Point.Y) {
+				count++
+			}
+		}
+	}
+
+	return count
+}
+
+func dot(m, n, o, p Point3D) float64 {
+	return m.Sub(n).Dot(o.Sub(p))
+}
+
+type Intersection struct {
+	Point Point3D
+	Value float64
+}
+
+// Source: https://stackoverflow.com/a/2316934/7149107
+//
+// Source: https://paulbourke.net/geometry/pointlineplane/
+func intersect(a, b Line) (Intersection, Intersection) {
+	p1 := a.Start
+	p2 := p1.Add(a.Direction)
+
+	p3 := b.Start
+	p4 := p3.Add(b.Direction)
+
+	mua := (dot(p1, p3, p4, p3)*dot(p4, p3, p2, p1) - dot(p1, p3, p2, p1)*dot(p4, p3, p4, p3)) /
+		(dot(p2, p1, p2, p1)*dot(p4, p3, p4, p3) - dot(p4, p3, p2, p1)*dot(p4, p3, p2, p1))
+
+	mub := (dot(p1, p3, p4, p3) + mua*dot(p4, p3, p2, p1)) / dot(p4, p3, p4, p3)
+
+	return Intersection{
+		Point: p1.Add(p2.Sub(p1).Mul(mua)),
+		Value: mua,
+	}, Intersection{
+		Point: p3.Add(p4.Sub(p3).Mul(mub)),
+		Value: mub,
+	}
+}
@@ -0,0 +1,34 @@
+package nevertellmetheodds
+
+import (
+	"io"
+	"testing"
+
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/internal/puzzletest"
+)
+
+func TestExamples(t *testing.T) {
+	puzzletest.Run(t, []puzzletest.Case{
+		{
+			Name: "part 1",
+			Solve: func(input io.Reader) (int, error) {
+				hailstones, err := ParseHailstones(input)
+				if err != nil {
+					return 0, err
+				}
+
+				return countIntersections(hailstones, [2]float64{7, 27}), nil
+			},
+			Input: "testdata/example.txt",
+			Want:  2,
+		},
+	})
+}
+
+func BenchmarkParse(b *testing.B) {
+	puzzletest.Benchmark(b, 24, "testdata/example.txt", ParseHailstones)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	puzzletest.Benchmark(b, 24, "testdata/example.txt", Part1)
+}
@@ -0,0 +1,10 @@
+//go:build !z3
+
+package nevertellmetheodds
+
+import "errors"
+
+// throwRock is unavailable unless built with the z3 tag, as the library is missing on many platforms.
+func throwRock([]Line) (RockPosition, error) {
+	return RockPosition{}, errors.New("built without z3 support")
+}
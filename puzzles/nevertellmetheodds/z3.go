@@ -0,0 +1,64 @@
+//go:build z3
+
+package nevertellmetheodds
+
+import (
+	"fmt"
+
+	"github.com/mitchellh/go-z3"
+)
+
+// throwRock finds the position to throw a rock from so that it hits every hailstone.
+//
+// Three hailstones are enough to determine the trajectory, so only they are passed to the Z3 solver.
+func throwRock(hailstones []Line) (RockPosition, error) {
+	config := z3.NewConfig()
+	z3Ctx := z3.NewContext(config)
+	config.Close()
+	defer z3Ctx.Close()
+
+	solver := z3Ctx.NewSolver()
+	defer solver.Close()
+
+	x := z3Ctx.Const(z3Ctx.Symbol("x"), z3Ctx.IntSort())
+	y := z3Ctx.Const(z3Ctx.Symbol("y"), z3Ctx.IntSort())
+	z := z3Ctx.Const(z3Ctx.Symbol("z"), z3Ctx.IntSort())
+
+	dx := z3Ctx.Const(z3Ctx.Symbol("dx"), z3Ctx.IntSort())
+	dy := z3Ctx.Const(z3Ctx.Symbol("dy"), z3Ctx.IntSort())
+	dz := z3Ctx.Const(z3Ctx.Symbol("dz"), z3Ctx.IntSort())
+
+	t := [3]*z3.AST{
+		z3Ctx.Const(z3Ctx.Symbol("t1"), z3Ctx.IntSort()),
+		z3Ctx.Const(z3Ctx.Symbol("t2"), z3Ctx.IntSort()),
+		z3Ctx.Const(z3Ctx.Symbol("t3"), z3Ctx.IntSort()),
+	}
+
+	for i, hailstone := range hailstones[:3] {
+		px := z3Ctx.Int64(int64(hailstone.Start.X), z3Ctx.IntSort())
+		pdx := z3Ctx.Int64(int64(hailstone.Direction.X), z3Ctx.IntSort())
+		solver.Assert(x.Add(dx.Mul(t[i])).Eq(px.Add(pdx.Mul(t[i]))))
+
+		py := z3Ctx.Int64(int64(hailstone.Start.Y), z3Ctx.IntSort())
+		pdy := z3Ctx.Int64(int64(hailstone.Direction.Y), z3Ctx.IntSort())
+		solver.Assert(y.Add(dy.Mul(t[i])).Eq(py.Add(pdy.Mul(t[i]))))
+
+		pz := z3Ctx.Int64(int64(hailstone.Start.Z), z3Ctx.IntSort())
+		pdz := z3Ctx.Int64(int64(hailstone.Direction.Z), z3Ctx.IntSort())
+		solver.Assert(z.Add(dz.Mul(t[i])).Eq(pz.Add(pdz.Mul(t[i]))))
+	}
+
+	if v := solver.Check(); v != z3.True {
+		return RockPosition{}, fmt.Errorf("no solutions")
+	}
+
+	model := solver.Model()
+	assignments := model.Assignments()
+	_ = model.Close()
+
+	return RockPosition{
+		X: assignments["x"].Int64(),
+		Y: assignments["y"].Int64(),
+		Z: assignments["z"].Int64(),
+	}, nil
+}
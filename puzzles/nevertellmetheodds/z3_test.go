@@ -0,0 +1,24 @@
+//go:build z3
+
+package nevertellmetheodds
+
+import (
+	"testing"
+
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/internal/puzzletest"
+)
+
+func TestExamplesZ3(t *testing.T) {
+	puzzletest.Run(t, []puzzletest.Case{
+		{
+			Name:  "part 2",
+			Solve: Part2,
+			Input: "testdata/example.txt",
+			Want:  47,
+		},
+	})
+}
+
+func BenchmarkPart2(b *testing.B) {
+	puzzletest.Benchmark(b, 24, "testdata/example.txt", Part2)
+}
@@ -1,11 +1,12 @@
-package main
+// Package parabolicreflectordish solves day 14 of Advent of Code 2023, "Parabolic Reflector Dish".
+package parabolicreflectordish
 
 import (
-	"bufio"
 	"fmt"
-	"log/slog"
-	"os"
+	"io"
 	"strings"
+
+	"github.com/harmlessevil/advent-of-code-2023/parse"
 )
 
 type Tile int
@@ -20,10 +21,21 @@ type Row []Tile
 
 type Platform []Row
 
-func runMain() error {
-	platform, err := parsePlatform("input.txt")
+func Part1(input io.Reader) (int, error) {
+	platform, err := ParsePlatform(input)
+	if err != nil {
+		return 0, fmt.Errorf("parse platform: %w", err)
+	}
+
+	platform.tiltNorth()
+
+	return totalLoad(platform), nil
+}
+
+func Part2(input io.Reader) (int, error) {
+	platform, err := ParsePlatform(input)
 	if err != nil {
-		return fmt.Errorf("parse platform: %w", err)
+		return 0, fmt.Errorf("parse platform: %w", err)
 	}
 
 	for i := 0; i < 1_000; i++ {
@@ -33,33 +45,36 @@ func runMain() error {
 		platform.tiltEast()
 	}
 
-	fmt.Println(totalLoad(platform))
-
-	return nil
+	return totalLoad(platform), nil
 }
 
-func parsePlatform(filename string) (Platform, error) {
-	f, err := os.Open(filename)
-	if err != nil {
-		return nil, fmt.Errorf("read input file: %w", err)
-	}
-	defer f.Close()
-
+func ParsePlatform(r io.Reader) (Platform, error) {
 	var platform Platform
 
-	scanner := bufio.NewScanner(f)
+	scanner := parse.NewScanner(r)
 
 	for scanner.Scan() {
 		line := scanner.Text()
+		if line == "" {
+			return nil, scanner.Errorf(0, "empty row")
+		}
+
+		if len(platform) > 0 && len(line) != len(platform[0]) {
+			return nil, scanner.Errorf(0, "row has %d tiles, expected %d", len(line), len(platform[0]))
+		}
 
 		row := make(Row, len(line))
 		for j, symbol := range line {
 			var tile Tile
 			switch symbol {
+			case '.':
+				tile = TileEmptySpace
 			case 'O':
 				tile = TileRoundedRock
 			case '#':
 				tile = TileCubeShapedRock
+			default:
+				return nil, scanner.Errorf(j+1, "unknown tile %q", symbol)
 			}
 
 			row[j] = tile
@@ -72,6 +87,10 @@ func parsePlatform(filename string) (Platform, error) {
 		return nil, fmt.Errorf("scan: %w", err)
 	}
 
+	if len(platform) == 0 {
+		return nil, scanner.Errorf(0, "expected a platform")
+	}
+
 	return platform, nil
 }
 
@@ -200,10 +219,3 @@ func totalLoad(platform Platform) int {
 
 	return sum
 }
-
-func main() {
-	if err := runMain(); err != nil {
-		slog.Error("program aborted", slog.Any("error", err))
-		os.Exit(1)
-	}
-}
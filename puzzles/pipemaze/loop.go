@@ -0,0 +1,41 @@
+package pipemaze
+
+import "github.com/harmlessevil/advent-of-code-2023/grid"
+
+// Loop of pipes, tiles in the order of walking it from the start.
+type Loop []grid.Point
+
+// Area of the polygon through centres of the tiles, by the shoelace formula.
+func (l Loop) Area() int {
+	area := 0
+	for i, point := range l {
+		next := l[(i+1)%len(l)]
+		area += point.X*next.Y - point.Y*next.X
+	}
+
+	if area < 0 {
+		area = -area
+	}
+
+	return area / 2
+}
+
+// Enclosed counts tiles inside the loop by Pick's theorem: the area is the number of points inside plus half the number
+// of points on the boundary minus one, and points are centres of tiles.
+func (l Loop) Enclosed() int {
+	return l.Area() - len(l)/2 + 1
+}
+
+// Polyline returns corners of the loop, dropping tiles of straight pipes. It starts at the first corner from the start,
+// which may be the start itself, and ends with it again to close the loop.
+func (l Loop) Polyline() []grid.Point {
+	var corners []grid.Point
+	for i, point := range l {
+		prev, next := l[(i+len(l)-1)%len(l)], l[(i+1)%len(l)]
+		if point.Sub(prev) != next.Sub(point) {
+			corners = append(corners, point)
+		}
+	}
+
+	return append(corners, corners[0])
+}
@@ -0,0 +1,373 @@
+// Package pipemaze solves day 10 of Advent of Code 2023, "Pipe Maze".
+package pipemaze
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+
+	"github.com/harmlessevil/advent-of-code-2023/grid"
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+)
+
+type Tile struct {
+	IsOnMainLoop bool
+	Neighbors    []grid.Point
+	VisitStatus  VisitStatus
+}
+
+type Sketch = grid.Grid[Tile]
+
+type VisitStatus int
+
+const (
+	VisitStatusNotVisited VisitStatus = iota
+	VisitStatusPendingVisit
+	VisitStatusVisited
+)
+
+// Report describes the main loop.
+type Report struct {
+	Count    int          `json:"count"` // steps to the farthest tile for part 1, enclosed tiles for part 2
+	Start    grid.Point   `json:"start"`
+	Farthest grid.Point   `json:"farthest"`
+	Length   int          `json:"length"`
+	Enclosed int          `json:"enclosed"`
+	Polyline []grid.Point `json:"polyline"` // corners of the loop, closed
+}
+
+func (r Report) Answer() int {
+	return r.Count
+}
+
+func Part1(input io.Reader) (int, error) {
+	_, loop, err := parseLoop(input)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(loop) / 2, nil
+}
+
+func Part2(input io.Reader) (int, error) {
+	sketch, loop, err := parseLoop(input)
+	if err != nil {
+		return 0, err
+	}
+
+	return enclosedTiles(sketch, loop)
+}
+
+func Part1Report(input io.Reader) (Report, error) {
+	_, loop, err := parseLoop(input)
+	if err != nil {
+		return Report{}, err
+	}
+
+	report := newReport(loop)
+	report.Count = len(loop) / 2
+
+	return report, nil
+}
+
+func Part2Report(input io.Reader) (Report, error) {
+	sketch, loop, err := parseLoop(input)
+	if err != nil {
+		return Report{}, err
+	}
+
+	report := newReport(loop)
+	if report.Count, err = enclosedTiles(sketch, loop); err != nil {
+		return Report{}, err
+	}
+
+	return report, nil
+}
+
+// enclosedTiles counts tiles enclosed by the loop by a flood fill, checking the count with Pick's theorem.
+func enclosedTiles(sketch Sketch, loop Loop) (int, error) {
+	count := sketch.Width*sketch.Height - len(loop) - countOutsideTiles(sketch)
+
+	if enclosed := loop.Enclosed(); count != enclosed {
+		return 0, fmt.Errorf("flood fill found %d enclosed tiles, but Pick's theorem gives %d", count, enclosed)
+	}
+
+	return count, nil
+}
+
+func parseLoop(input io.Reader) (Sketch, Loop, error) {
+	sketch, start, err := ParseSketch(input)
+	if err != nil {
+		return Sketch{}, nil, fmt.Errorf("parse sketch: %w", err)
+	}
+
+	loop, err := findMainLoop(sketch, start)
+	if err != nil {
+		return Sketch{}, nil, fmt.Errorf("find main loop: %w", err)
+	}
+
+	return sketch, loop, nil
+}
+
+func newReport(loop Loop) Report {
+	return Report{
+		Start:    loop[0],
+		Farthest: loop[len(loop)/2],
+		Length:   len(loop),
+		Enclosed: loop.Enclosed(),
+		Polyline: loop.Polyline(),
+	}
+}
+
+// WriteCSV writes corners of the loop, a row of coordinates for each.
+func (r Report) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"x", "y"}); err != nil {
+		return err
+	}
+
+	for _, point := range r.Polyline {
+		if err := writer.Write([]string{strconv.Itoa(point.X), strconv.Itoa(point.Y)}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
+
+var symbolToDelta = map[rune][2]grid.Point{
+	'|': {{X: 0, Y: -1}, {X: 0, Y: 1}},
+	'-': {{X: -1, Y: 0}, {X: 1, Y: 0}},
+	'L': {{X: 0, Y: -1}, {X: 1, Y: 0}},
+	'J': {{X: 0, Y: -1}, {X: -1, Y: 0}},
+	'7': {{X: -1, Y: 0}, {X: 0, Y: 1}},
+	'F': {{X: 1, Y: 0}, {X: 0, Y: 1}},
+}
+
+func ParseSketch(r io.Reader) (Sketch, grid.Point, error) {
+	start := grid.Point{X: -1, Y: -1}
+
+	sketch, err := grid.Parse(r, func(point grid.Point, symbol rune) (Tile, error) {
+		switch symbol {
+		case 'S':
+			if start.X != -1 {
+				return Tile{}, fmt.Errorf("second start, the first one is at %d:%d", start.Y+1, start.X+1)
+			}
+
+			start = point
+			return Tile{}, nil
+		case '.':
+			return Tile{}, nil
+		}
+
+		delta, ok := symbolToDelta[symbol]
+		if !ok {
+			return Tile{}, fmt.Errorf("unknown pipe")
+		}
+
+		return Tile{
+			Neighbors: []grid.Point{point.Add(delta[0]), point.Add(delta[1])},
+		}, nil
+	})
+	if err != nil {
+		return Sketch{}, grid.Point{}, err
+	}
+
+	if start.X == -1 {
+		return Sketch{}, grid.Point{}, fmt.Errorf("no start")
+	}
+
+	if err := fixupStartShape(sketch, start); err != nil {
+		return Sketch{}, grid.Point{}, err
+	}
+
+	return sketch, start, nil
+}
+
+// errorAt reports a problem with the tile at the point.
+func errorAt(point grid.Point, format string, args ...any) error {
+	return parse.Errorf(point.Y+1, point.X+1, format, args...)
+}
+
+func fixupStartShape(sketch Sketch, start grid.Point) error {
+	for _, delta := range [][2]grid.Point{
+		symbolToDelta['|'],
+		symbolToDelta['-'],
+		symbolToDelta['L'],
+		symbolToDelta['J'],
+		symbolToDelta['7'],
+		symbolToDelta['F'],
+	} {
+		from := start.Add(delta[0])
+		to := start.Add(delta[1])
+
+		if !sketch.InBounds(from) || !sketch.InBounds(to) {
+			continue
+		}
+
+		if !slices.Contains(sketch.At(from).Neighbors, start) || !slices.Contains(sketch.At(to).Neighbors, start) {
+			continue
+		}
+
+		sketch.Ref(start).Neighbors = []grid.Point{from, to}
+		return nil
+	}
+
+	return errorAt(start, "start doesn't connect to exactly two pipes")
+}
+
+// findMainLoop walks the loop from the start, marking its tiles, and returns them in order.
+func findMainLoop(sketch Sketch, start grid.Point) (Loop, error) {
+	prevTile := start
+	currentTile := sketch.At(start).Neighbors[0]
+	loop := Loop{start}
+
+	for currentTile != start {
+		if !sketch.InBounds(currentTile) || !slices.Contains(sketch.At(currentTile).Neighbors, prevTile) {
+			return nil, errorAt(prevTile, "pipe leads out of the loop")
+		}
+
+		sketch.Ref(currentTile).IsOnMainLoop = true
+		loop = append(loop, currentTile)
+		neighbors := sketch.At(currentTile).Neighbors
+
+		if neighbors[0] != prevTile {
+			prevTile = currentTile
+			currentTile = neighbors[0]
+		} else {
+			prevTile = currentTile
+			currentTile = neighbors[1]
+		}
+	}
+
+	sketch.Ref(currentTile).IsOnMainLoop = true
+
+	return loop, nil
+}
+
+func countOutsideTiles(sketch Sketch) int {
+	res := 0
+
+	for y := 0; y < sketch.Height; y++ {
+		for _, x := range []int{0, sketch.Width - 1} {
+			if point := (grid.Point{X: x, Y: y}); sketch.At(point).VisitStatus == VisitStatusNotVisited {
+				res += countOutsideTilesFrom(sketch, point)
+			}
+		}
+	}
+
+	for x := 0; x < sketch.Width; x++ {
+		for _, y := range []int{0, sketch.Height - 1} {
+			if point := (grid.Point{X: x, Y: y}); sketch.At(point).VisitStatus == VisitStatusNotVisited {
+				res += countOutsideTilesFrom(sketch, point)
+			}
+		}
+	}
+
+	return res
+}
+
+type FloodFillPoint struct {
+	Point grid.Point
+	Side  grid.Point
+}
+
+func countOutsideTilesFrom(sketch Sketch, start grid.Point) int {
+	res := 0
+
+	for points := []FloodFillPoint{{Point: start}}; len(points) > 0; points = points[1:] {
+		point := points[0]
+
+		sketch.Ref(point.Point).VisitStatus = VisitStatusVisited
+
+		tile := sketch.At(point.Point)
+		if !tile.IsOnMainLoop {
+			res++
+		}
+
+		for _, nextPoint := range sketch.Neighbors8(point.Point) {
+			if sketch.At(nextPoint).VisitStatus != VisitStatusNotVisited {
+				continue
+			}
+
+			if tile.IsOnMainLoop && areVectorsIntersect(
+				point.Point.Sub(point.Side), nextPoint, tile.Neighbors[0], tile.Neighbors[1],
+			) {
+				continue
+			}
+
+			sketch.Ref(nextPoint).VisitStatus = VisitStatusPendingVisit
+			points = append(points, FloodFillPoint{
+				Point: nextPoint,
+				Side:  nextPoint.Sub(point.Point),
+			})
+		}
+	}
+
+	return res
+}
+
+// orientation determines the orientation of three given points (a, b, c).
+//
+// Assume the segment from a to b as a vector.
+// We can find the order by checking the direction of the vector from b to c relative to the vector from a to b.
+//
+//   - If the vector from b to c deviates to the left of the vector from a to b,
+//     then the points are in counter-clockwise order.
+//   - If the vector from b to c deviates to the right of the vector from a to b,
+//     then the points are in clockwise order.
+//   - If the vector from b to c is in the same direction as that from a to b or in the exact opposite direction,
+//     then the points are collinear.
+//
+// The function returns 1 if the points are in counter-clockwise order,
+// -1 if they are in clockwise order, and 0 if they are collinear.
+func orientation(a, b, c grid.Point) int {
+	switch val := (c.Y-a.Y)*(b.X-a.X) - (b.Y-a.Y)*(c.X-a.X); {
+	case val > 0:
+		return 1
+	case val < 0:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// areVectorsIntersect determines if the line segments formed by points a and b, and points c and d intersect.
+func areVectorsIntersect(a, b, c, d grid.Point) bool {
+	o1 := orientation(a, b, c)
+	o2 := orientation(a, b, d)
+	o3 := orientation(c, d, a)
+	o4 := orientation(c, d, b)
+
+	if o1 != o2 && o3 != o4 {
+		return true
+	}
+
+	if o1 == 0 && isPointOnSegment(a, c, b) {
+		return true
+	}
+
+	if o2 == 0 && isPointOnSegment(a, d, b) {
+		return true
+	}
+
+	if o3 == 0 && isPointOnSegment(c, a, d) {
+		return true
+	}
+
+	if o4 == 0 && isPointOnSegment(c, b, d) {
+		return true
+	}
+
+	return false
+}
+
+// isPointOnSegment determines if point b lies on the line segment formed by points a and c.
+func isPointOnSegment(a, b, c grid.Point) bool {
+	return b.X <= max(a.X, c.X) && b.X >= min(a.X, c.X) &&
+		b.Y <= max(a.Y, c.Y) && b.Y >= min(a.Y, c.Y)
+}
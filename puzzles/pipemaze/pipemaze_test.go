@@ -0,0 +1,110 @@
+package pipemaze
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/internal/puzzletest"
+)
+
+func TestExamples(t *testing.T) {
+	puzzletest.Run(t, []puzzletest.Case{
+		{
+			Name:  "part 1 simple loop",
+			Solve: Part1,
+			Input: "testdata/example1.txt",
+			Want:  4,
+		},
+		{
+			Name:  "part 1 complex loop",
+			Solve: Part1,
+			Input: "testdata/example2.txt",
+			Want:  8,
+		},
+		{
+			Name:  "part 2",
+			Solve: Part2,
+			Input: "testdata/example3.txt",
+			Want:  4,
+		},
+		{
+			Name:  "part 2 larger",
+			Solve: Part2,
+			Input: "testdata/example4.txt",
+			Want:  8,
+		},
+		{
+			Name:  "part 2 junk pipes",
+			Solve: Part2,
+			Input: "testdata/example5.txt",
+			Want:  10,
+		},
+	})
+}
+
+func BenchmarkParse(b *testing.B) {
+	puzzletest.Benchmark(b, 10, "testdata/example1.txt", func(input io.Reader) (Sketch, error) {
+		sketch, _, err := ParseSketch(input)
+		return sketch, err
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	puzzletest.Benchmark(b, 10, "testdata/example1.txt", Part1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	puzzletest.Benchmark(b, 10, "testdata/example3.txt", Part2)
+}
+
+func TestLoop(t *testing.T) {
+	file, err := os.Open("testdata/example4.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	_, loop, err := parseLoop(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Every tile of the loop is one step from the next one.
+	for i, point := range loop {
+		if step := loop[(i+1)%len(loop)].Sub(point); abs(step.X)+abs(step.Y) != 1 {
+			t.Fatalf("step %d of the loop goes from %v by %v", i, point, step)
+		}
+	}
+
+	if got := loop.Enclosed(); got != 8 {
+		t.Errorf("got %d enclosed tiles, want 8", got)
+	}
+
+	polyline := loop.Polyline()
+	if polyline[0] != polyline[len(polyline)-1] {
+		t.Errorf("polyline %v isn't closed", polyline)
+	}
+
+	// Corners of a loop through the middle of tiles enclose the same area.
+	if area := Loop(polyline[:len(polyline)-1]).Area(); area != loop.Area() {
+		t.Errorf("got polyline area %d, want %d", area, loop.Area())
+	}
+
+	square := Loop{{X: 1, Y: 1}, {X: 2, Y: 1}, {X: 3, Y: 1}, {X: 3, Y: 2}, {X: 3, Y: 3}, {X: 2, Y: 3}, {X: 1, Y: 3},
+		{X: 1, Y: 2}}
+
+	var out strings.Builder
+	if err := newReport(square).WriteCSV(&out); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "x,y\n1,1\n3,1\n3,3\n1,3\n1,1\n"; out.String() != want {
+		t.Errorf("got csv\n%s\nwant\n%s", out.String(), want)
+	}
+}
+
+func abs(n int) int {
+	return max(n, -n)
+}
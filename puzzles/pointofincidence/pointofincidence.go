@@ -0,0 +1,156 @@
+// Package pointofincidence solves day 13 of Advent of Code 2023, "Point of Incidence".
+package pointofincidence
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+)
+
+type Pattern int
+
+const (
+	PatternAsh Pattern = iota
+	PatternRocks
+)
+
+type Note [][]Pattern
+
+func Part1(input io.Reader) (int, error) {
+	return solve(input, 0)
+}
+
+func Part2(input io.Reader) (int, error) {
+	return solve(input, 1)
+}
+
+// solve summarizes reflection lines of every note, where each reflection has exactly smudges mismatched patterns.
+func solve(input io.Reader, smudges int) (int, error) {
+	scanner := parse.NewScanner(input)
+
+	note, err := parseNote(scanner)
+	if err != nil {
+		return 0, fmt.Errorf("parse note: %w", err)
+	}
+
+	sum := 0
+
+	for len(note) > 0 {
+		row := findReflectionRow(note, smudges)
+		column := findReflectionColumn(note, smudges)
+
+		sum += 100*row + column
+
+		slog.Debug("reflection", slog.Int("row", row), slog.Int("column", column))
+
+		note, err = parseNote(scanner)
+		if err != nil {
+			return 0, fmt.Errorf("parse note: %w", err)
+		}
+	}
+
+	return sum, nil
+}
+
+func ParseNotes(r io.Reader) ([]Note, error) {
+	var notes []Note
+
+	scanner := parse.NewScanner(r)
+	for {
+		note, err := parseNote(scanner)
+		if err != nil {
+			return nil, fmt.Errorf("parse note: %w", err)
+		}
+
+		if len(note) == 0 {
+			return notes, nil
+		}
+
+		notes = append(notes, note)
+	}
+}
+
+func parseNote(scanner *parse.Scanner) (Note, error) {
+	var note Note
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			break
+		}
+
+		if len(note) > 0 && len(line) != len(note[0]) {
+			return nil, scanner.Errorf(0, "row has %d patterns, expected %d", len(line), len(note[0]))
+		}
+
+		row := make([]Pattern, len(line))
+		for i, pattern := range line {
+			switch pattern {
+			case '.':
+				row[i] = PatternAsh
+			case '#':
+				row[i] = PatternRocks
+			default:
+				return nil, scanner.Errorf(i+1, "unknown pattern %q", pattern)
+			}
+		}
+
+		note = append(note, row)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan: %w", err)
+	}
+
+	return note, nil
+}
+
+func findReflectionColumn(note Note, smudges int) int {
+searchLine:
+	for line := 1; line < len(note[0]); line++ {
+		smudgeCount := 0
+
+		for j := 0; j < min(line, len(note[0])-line); j++ {
+			for i := range note {
+				if note[i][line-j-1] != note[i][line+j] {
+					smudgeCount++
+					if smudgeCount > smudges {
+						continue searchLine
+					}
+				}
+			}
+		}
+
+		if smudgeCount == smudges {
+			return line
+		}
+	}
+
+	return 0
+}
+
+func findReflectionRow(note Note, smudges int) int {
+searchLine:
+	for line := 1; line < len(note); line++ {
+		smudgeCount := 0
+
+		for i := 0; i < min(line, len(note)-line); i++ {
+			for j := range note[0] {
+				if note[line-i-1][j] != note[line+i][j] {
+					smudgeCount++
+					if smudgeCount > smudges {
+						continue searchLine
+					}
+				}
+			}
+		}
+
+		if smudgeCount == smudges {
+			return line
+		}
+	}
+
+	return 0
+}
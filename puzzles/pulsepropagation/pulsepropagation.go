@@ -0,0 +1,278 @@
+// Package pulsepropagation solves day 20 of Advent of Code 2023, "Pulse Propagation".
+package pulsepropagation
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"github.com/harmlessevil/advent-of-code-2023/numtheory"
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+)
+
+type Pulse int
+
+const (
+	PulseLow Pulse = iota
+	PulseHigh
+)
+
+func (p Pulse) String() string {
+	return map[Pulse]string{
+		PulseLow:  "-low-",
+		PulseHigh: "-high-",
+	}[p]
+}
+
+type Module interface {
+	Receive(from string, pulse Pulse) *Pulse
+}
+
+type FlipFlop struct {
+	IsActive bool
+}
+
+func (f *FlipFlop) Receive(_ string, pulse Pulse) *Pulse {
+	if pulse == PulseHigh {
+		return nil
+	}
+
+	f.IsActive = !f.IsActive
+
+	var res Pulse
+	if f.IsActive {
+		res = PulseHigh
+	}
+
+	return &res
+}
+
+type Conjunction struct {
+	Inputs map[string]Pulse
+}
+
+func (c *Conjunction) Receive(from string, pulse Pulse) *Pulse {
+	c.Inputs[from] = pulse
+
+	var res Pulse
+	for _, p := range c.Inputs {
+		if p != PulseHigh {
+			res = PulseHigh
+			return &res
+		}
+	}
+
+	return &res
+}
+
+type Broadcaster struct{}
+
+func (b Broadcaster) Receive(_ string, pulse Pulse) *Pulse {
+	return &pulse
+}
+
+type WiredModule struct {
+	Module  Module
+	Outputs []string
+}
+
+type ModuleConfiguration map[string]WiredModule
+
+type Transmission struct {
+	Pulse       Pulse
+	Source      string
+	Destination string
+}
+
+func (t Transmission) String() string {
+	return fmt.Sprintf("%s %s> %s", t.Source, t.Pulse, t.Destination)
+}
+
+// PushButton sends a low pulse to the broadcaster and processes all resulting transmissions, reporting each of them to
+// observe.
+func (c ModuleConfiguration) PushButton(observe func(transmission Transmission)) {
+	c.pushButton(slog.Default(), observe)
+}
+
+// pushButton is PushButton logging transmissions to the logger.
+func (c ModuleConfiguration) pushButton(logger *slog.Logger, observe func(transmission Transmission)) {
+	for queue := []Transmission{{Source: "button", Destination: "broadcaster"}}; len(queue) > 0; queue = queue[1:] {
+		transmission := queue[0]
+
+		observe(transmission)
+
+		logger.Debug("sent", slog.Any("transmission", transmission))
+
+		destination := c[transmission.Destination]
+		if destination.Module == nil {
+			continue
+		}
+
+		pulse := destination.Module.Receive(transmission.Source, transmission.Pulse)
+		if pulse == nil {
+			continue
+		}
+
+		for _, output := range destination.Outputs {
+			queue = append(queue, Transmission{
+				Pulse:       *pulse,
+				Source:      transmission.Destination,
+				Destination: output,
+			})
+		}
+	}
+}
+
+// ButtonPresses is a maximum amount of 12-bit numbers. It will overflow all counters at least once.
+const ButtonPresses = 1 << 12
+
+func Part1(input io.Reader) (int, error) {
+	config, _, err := ParseModuleConfiguration(input)
+	if err != nil {
+		return 0, fmt.Errorf("parse module configuration: %w", err)
+	}
+
+	var pulses [2]int
+	for i := 0; i < 1000; i++ {
+		config.PushButton(func(transmission Transmission) {
+			pulses[transmission.Pulse]++
+		})
+	}
+
+	return pulses[PulseLow] * pulses[PulseHigh], nil
+}
+
+func Part2(input io.Reader) (int, error) {
+	config, inputs, err := ParseModuleConfiguration(input)
+	if err != nil {
+		return 0, fmt.Errorf("parse module configuration: %w", err)
+	}
+
+	if len(inputs["rx"]) != 1 {
+		return 0, fmt.Errorf("expected rx to have exactly 1 input, got %d", len(inputs["rx"]))
+	}
+
+	// rx gets a low pulse once the conjunction feeding it remembers high pulses from all of its inputs. Every input
+	// sends one periodically, so the answer is the lcm of the rounds in which they first do.
+	target := inputs["rx"][0]
+	if _, ok := config[target].Module.(*Conjunction); !ok {
+		return 0, fmt.Errorf("expected rx to be fed by a conjunction, got %s", target)
+	}
+
+	sources := inputs[target]
+	if len(sources) == 0 {
+		return 0, fmt.Errorf("conjunction %s feeding rx has no inputs", target)
+	}
+
+	first := make(map[string]int64, len(sources))
+	for i := 0; i < ButtonPresses && len(first) < len(sources); i++ {
+		config.pushButton(slog.With(slog.Int("round", i)), func(transmission Transmission) {
+			if transmission.Destination != target || transmission.Pulse != PulseHigh {
+				return
+			}
+
+			if _, ok := first[transmission.Source]; !ok {
+				first[transmission.Source] = int64(i + 1)
+			}
+		})
+	}
+
+	rounds := make([]int64, 0, len(sources))
+	for _, source := range sources {
+		round, ok := first[source]
+		if !ok {
+			return 0, fmt.Errorf("%s never sends a high pulse to %s in %d button presses", source, target, ButtonPresses)
+		}
+
+		rounds = append(rounds, round)
+	}
+
+	res, err := numtheory.LCMOf(rounds)
+	if err != nil {
+		return 0, fmt.Errorf("button presses: %w", err)
+	}
+
+	return int(res), nil
+}
+
+// ParseModuleConfiguration returns the configuration and inputs of every module.
+func ParseModuleConfiguration(r io.Reader) (ModuleConfiguration, map[string][]string, error) {
+	config := ModuleConfiguration{}
+	inputs := map[string][]string{}
+
+	scanner := parse.NewScanner(r)
+
+	for scanner.Scan() {
+		moduleText, outputsText, err := scanner.Field().Cut(" -> ")
+		if err != nil {
+			return nil, nil, err
+		}
+
+		name, module, err := parseModule(moduleText)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if _, ok := config[name]; ok {
+			return nil, nil, moduleText.Errorf("duplicate module %s", name)
+		}
+
+		var outputs []string
+		for _, output := range outputsText.Split(", ") {
+			if output.Text == "" {
+				return nil, nil, output.Errorf("expected output module name")
+			}
+
+			outputs = append(outputs, output.Text)
+		}
+
+		config[name] = WiredModule{
+			Module:  module,
+			Outputs: outputs,
+		}
+
+		for _, output := range outputs {
+			inputs[output] = append(inputs[output], name)
+		}
+	}
+
+	if _, ok := config["broadcaster"]; !ok {
+		return nil, nil, fmt.Errorf("no broadcaster")
+	}
+
+	for name, modules := range inputs {
+		if c, ok := config[name].Module.(*Conjunction); ok {
+			c.Inputs = make(map[string]Pulse, len(modules))
+
+			for _, module := range modules {
+				c.Inputs[module] = PulseLow
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("scan: %w", err)
+	}
+
+	return config, inputs, nil
+}
+
+func parseModule(field parse.Field) (string, Module, error) {
+	name, module := field.Text, Module(Broadcaster{})
+
+	switch {
+	case strings.HasPrefix(name, "%"):
+		name, module = name[1:], &FlipFlop{}
+	case strings.HasPrefix(name, "&"):
+		name, module = name[1:], &Conjunction{}
+	case name != "broadcaster":
+		return "", nil, field.Errorf("expected %%flip-flop, &conjunction or broadcaster, got %q", name)
+	}
+
+	if name == "" {
+		return "", nil, field.Errorf("expected module name")
+	}
+
+	return name, module, nil
+}
@@ -0,0 +1,58 @@
+package pulsepropagation
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/internal/puzzletest"
+)
+
+// Part 2 has no example in the puzzle, it needs a configuration with the rx module.
+func TestExamples(t *testing.T) {
+	puzzletest.Run(t, []puzzletest.Case{
+		{
+			Name:  "part 1",
+			Solve: Part1,
+			Input: "testdata/example1.txt",
+			Want:  32000000,
+		},
+		{
+			Name:  "part 1 with a conjunction",
+			Solve: Part1,
+			Input: "testdata/example2.txt",
+			Want:  11687500,
+		},
+	})
+}
+
+func TestPart2(t *testing.T) {
+	// Chains of flip-flops first send a high pulse to the hub after 2 and 4 presses.
+	got, err := Part2(strings.NewReader(
+		"broadcaster -> a1, c1\n%a1 -> a2\n%a2 -> hub\n%c1 -> c2\n%c2 -> c3\n%c3 -> hub\n&hub -> rx\n"))
+	if err != nil || got != 4 {
+		t.Errorf("got %d, %v, want 4", got, err)
+	}
+
+	for name, input := range map[string]string{
+		"no rx":                 "broadcaster -> a\n%a -> b\n",
+		"rx fed by a flip-flop": "broadcaster -> a\n%a -> rx\n",
+		"no feeders":            "broadcaster -> a\n&hub -> rx\n",
+		"feeder never high":     "broadcaster -> a\n%a -> hub\n&never -> hub\n&hub -> rx\n",
+	} {
+		if got, err := Part2(strings.NewReader(input)); err == nil {
+			t.Errorf("%s: got %d, want an error", name, got)
+		}
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	puzzletest.Benchmark(b, 20, "testdata/example1.txt", func(input io.Reader) (ModuleConfiguration, error) {
+		config, _, err := ParseModuleConfiguration(input)
+		return config, err
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	puzzletest.Benchmark(b, 20, "testdata/example1.txt", Part1)
+}
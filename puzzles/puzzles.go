@@ -0,0 +1,197 @@
+// Package puzzles is a registry of solvers for every day of Advent of Code 2023.
+package puzzles
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/alongwalk"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/aplenty"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/camelcards"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/clumsycrucible"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/cosmicexpansion"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/cubeconundrum"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/gearratios"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/hauntedwasteland"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/hotsprings"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/ifyougiveaseedafertilizer"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/lavaductlagoon"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/lenslibrary"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/miragemaintenance"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/nevertellmetheodds"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/parabolicreflectordish"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/pipemaze"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/pointofincidence"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/pulsepropagation"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/sandslabs"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/scratchcards"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/snowverload"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/stepcounter"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/thefloorwillbelava"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/trebuchet"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/waitforit"
+)
+
+// Solver reads the puzzle input and returns the answer.
+type Solver interface {
+	Solve(input io.Reader) (Result, error)
+}
+
+// Result is the answer with optional diagnostics telling how it was found.
+type Result struct {
+	Answer      int `json:"answer"`
+	Diagnostics any `json:"diagnostics,omitempty"`
+}
+
+// SolverFunc is an adapter to allow the use of ordinary functions as solvers.
+type SolverFunc func(input io.Reader) (int, error)
+
+func (f SolverFunc) Solve(input io.Reader) (Result, error) {
+	answer, err := f(input)
+	return Result{Answer: answer}, err
+}
+
+// Report is a result of a solver with diagnostics.
+type Report interface {
+	Answer() int
+}
+
+// ReportFunc is an adapter to allow the use of functions returning reports as solvers. The report becomes diagnostics.
+type ReportFunc[R Report] func(input io.Reader) (R, error)
+
+func (f ReportFunc[R]) Solve(input io.Reader) (Result, error) {
+	report, err := f(input)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return Result{
+		Answer:      report.Answer(),
+		Diagnostics: report,
+	}, nil
+}
+
+// Diagnoser is implemented by solvers whose diagnostics cost more than the answer alone, so that they are built only
+// when asked for.
+type Diagnoser interface {
+	Diagnose(input io.Reader) (Result, error)
+}
+
+// Diagnose solves with diagnostics if the solver builds them apart from the answer, like Solve otherwise.
+func Diagnose(solver Solver, input io.Reader) (Result, error) {
+	if diagnoser, ok := solver.(Diagnoser); ok {
+		return diagnoser.Diagnose(input)
+	}
+
+	return solver.Solve(input)
+}
+
+// Reported solves with the plain function, running the one returning a report only for diagnostics.
+func Reported[R Report](solve SolverFunc, report ReportFunc[R]) Solver {
+	return reported[R]{SolverFunc: solve, report: report}
+}
+
+type reported[R Report] struct {
+	SolverFunc
+	report ReportFunc[R]
+}
+
+func (r reported[R]) Diagnose(input io.Reader) (Result, error) {
+	return r.report.Solve(input)
+}
+
+type Puzzle struct {
+	Day   int
+	Name  string
+	Parts [2]Solver // nil if the puzzle doesn't have the part
+
+	// Parse only reads the input the way the parts do, to measure parsing apart from solving. Days solved while
+	// streaming the input read all of it at once instead.
+	Parse func(input io.Reader) error
+
+	// Streaming is set for days whose parts parse the input while solving it, so parsing can't be measured apart.
+	Streaming bool
+}
+
+// Part returns the solver of the part 1 or 2.
+func (p Puzzle) Part(part int) (Solver, error) {
+	if part < 1 || part > len(p.Parts) || p.Parts[part-1] == nil {
+		return nil, fmt.Errorf("day %d has no part %d", p.Day, part)
+	}
+
+	return p.Parts[part-1], nil
+}
+
+var registry = []Puzzle{
+	{Day: 1, Name: "trebuchet", Parts: [2]Solver{SolverFunc(trebuchet.Part1), SolverFunc(trebuchet.Part2)}, Parse: parser(trebuchet.ParseDocument), Streaming: true},
+	{Day: 2, Name: "cube-conundrum", Parts: [2]Solver{Reported(cubeconundrum.Part1, cubeconundrum.Part1Report), Reported(cubeconundrum.Part2, cubeconundrum.Part2Report)}, Parse: parser(cubeconundrum.ParseGames)},
+	{Day: 3, Name: "gear-ratios", Parts: [2]Solver{Reported(gearratios.Part1, gearratios.Part1Report), Reported(gearratios.Part2, gearratios.Part2Report)}, Parse: parser(gearratios.ParseSchematic), Streaming: true},
+	{Day: 4, Name: "scratchcards", Parts: [2]Solver{Reported(scratchcards.Part1, scratchcards.Part1Report), Reported(scratchcards.Part2, scratchcards.Part2Report)}, Parse: parser(scratchcards.ParseScratchcards), Streaming: true},
+	{Day: 5, Name: "if-you-give-a-seed-a-fertilizer", Parts: [2]Solver{Reported(ifyougiveaseedafertilizer.Part1, ifyougiveaseedafertilizer.Part1Report), Reported(ifyougiveaseedafertilizer.Part2, ifyougiveaseedafertilizer.Part2Report)}, Parse: parser(ifyougiveaseedafertilizer.ParseAlmanac)},
+	{Day: 6, Name: "wait-for-it", Parts: [2]Solver{SolverFunc(waitforit.Part1), SolverFunc(waitforit.Part2)}, Parse: parser(waitforit.ParseRaces)},
+	{Day: 7, Name: "camel-cards", Parts: [2]Solver{Reported(camelcards.Part1, camelcards.Part1Report), Reported(camelcards.Part2, camelcards.Part2Report)}, Parse: parseHands},
+	{Day: 8, Name: "haunted-wasteland", Parts: [2]Solver{Reported(hauntedwasteland.Part1, hauntedwasteland.Part1Report), Reported(hauntedwasteland.Part2, hauntedwasteland.Part2Report)}, Parse: parser(hauntedwasteland.ParseNetwork)},
+	{Day: 9, Name: "mirage-maintenance", Parts: [2]Solver{Reported(miragemaintenance.Part1, miragemaintenance.Part1Report), Reported(miragemaintenance.Part2, miragemaintenance.Part2Report)}, Parse: parser(miragemaintenance.ParseHistories), Streaming: true},
+	{Day: 10, Name: "pipe-maze", Parts: [2]Solver{Reported(pipemaze.Part1, pipemaze.Part1Report), Reported(pipemaze.Part2, pipemaze.Part2Report)}, Parse: parseSketch},
+	{Day: 11, Name: "cosmic-expansion", Parts: [2]Solver{SolverFunc(cosmicexpansion.Part1), SolverFunc(cosmicexpansion.Part2)}, Parse: parser(cosmicexpansion.ParseImage)},
+	{Day: 12, Name: "hot-springs", Parts: [2]Solver{Reported(hotsprings.Part1, hotsprings.Part1Report), Reported(hotsprings.Part2, hotsprings.Part2Report)}, Parse: parser(hotsprings.ParseRecords), Streaming: true},
+	{Day: 13, Name: "point-of-incidence", Parts: [2]Solver{SolverFunc(pointofincidence.Part1), SolverFunc(pointofincidence.Part2)}, Parse: parser(pointofincidence.ParseNotes)},
+	{Day: 14, Name: "parabolic-reflector-dish", Parts: [2]Solver{SolverFunc(parabolicreflectordish.Part1), SolverFunc(parabolicreflectordish.Part2)}, Parse: parser(parabolicreflectordish.ParsePlatform)},
+	{Day: 15, Name: "lens-library", Parts: [2]Solver{SolverFunc(lenslibrary.Part1), Reported(lenslibrary.Part2, lenslibrary.Part2Report)}, Parse: parser(lenslibrary.ParseSteps)},
+	{Day: 16, Name: "the-floor-will-be-lava", Parts: [2]Solver{SolverFunc(thefloorwillbelava.Part1), SolverFunc(thefloorwillbelava.Part2)}, Parse: parser(thefloorwillbelava.ParseContraption)},
+	{Day: 17, Name: "clumsy-crucible", Parts: [2]Solver{Reported(clumsycrucible.Part1, clumsycrucible.Part1Report), Reported(clumsycrucible.Part2, clumsycrucible.Part2Report)}, Parse: parser(clumsycrucible.ParseCityMap)},
+	{Day: 18, Name: "lavaduct-lagoon", Parts: [2]Solver{SolverFunc(lavaductlagoon.Part1), SolverFunc(lavaductlagoon.Part2)}, Parse: parseDigPlan},
+	{Day: 19, Name: "aplenty", Parts: [2]Solver{SolverFunc(aplenty.Part1), SolverFunc(aplenty.Part2)}, Parse: parseSystem},
+	{Day: 20, Name: "pulse-propagation", Parts: [2]Solver{SolverFunc(pulsepropagation.Part1), SolverFunc(pulsepropagation.Part2)}, Parse: parseModuleConfiguration},
+	{Day: 21, Name: "step-counter", Parts: [2]Solver{SolverFunc(stepcounter.Part1), SolverFunc(stepcounter.Part2)}, Parse: parser(stepcounter.ParseGardenMap)},
+	{Day: 22, Name: "sand-slabs", Parts: [2]Solver{SolverFunc(sandslabs.Part1), SolverFunc(sandslabs.Part2)}, Parse: parser(sandslabs.ParseStack)},
+	{Day: 23, Name: "a-long-walk", Parts: [2]Solver{SolverFunc(alongwalk.Part1), SolverFunc(alongwalk.Part2)}, Parse: parser(alongwalk.ParseHikingTrailMap)},
+	{Day: 24, Name: "never-tell-me-the-odds", Parts: [2]Solver{SolverFunc(nevertellmetheodds.Part1), SolverFunc(nevertellmetheodds.Part2)}, Parse: parser(nevertellmetheodds.ParseHailstones)},
+	{Day: 25, Name: "snowverload", Parts: [2]Solver{Reported(snowverload.Part1, snowverload.Part1Report)}, Parse: parser(snowverload.ParseWiring)},
+}
+
+// parser discards what the function parses.
+func parser[T any](parse func(input io.Reader) (T, error)) func(input io.Reader) error {
+	return func(input io.Reader) error {
+		_, err := parse(input)
+		return err
+	}
+}
+
+func parseHands(input io.Reader) error {
+	_, err := camelcards.ParseHands(input, camelcards.Standard)
+	return err
+}
+
+func parseDigPlan(input io.Reader) error {
+	_, err := lavaductlagoon.ParsePolygon(input, lavaductlagoon.ParsePlanInstruction)
+	return err
+}
+
+func parseSketch(input io.Reader) error {
+	_, _, err := pipemaze.ParseSketch(input)
+	return err
+}
+
+func parseSystem(input io.Reader) error {
+	_, _, err := aplenty.ParseSystem(input)
+	return err
+}
+
+func parseModuleConfiguration(input io.Reader) error {
+	_, _, err := pulsepropagation.ParseModuleConfiguration(input)
+	return err
+}
+
+// All returns puzzles for every day, ordered by day.
+func All() []Puzzle {
+	return append([]Puzzle{}, registry...)
+}
+
+func Lookup(day int) (Puzzle, error) {
+	if day < 1 || day > len(registry) {
+		return Puzzle{}, fmt.Errorf("no puzzle for day %d", day)
+	}
+
+	return registry[day-1], nil
+}
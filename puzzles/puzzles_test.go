@@ -0,0 +1,121 @@
+package puzzles
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type countReport struct {
+	Count int
+}
+
+func (r countReport) Answer() int {
+	return r.Count
+}
+
+func TestReportFunc(t *testing.T) {
+	solver := ReportFunc[countReport](func(input io.Reader) (countReport, error) {
+		data, err := io.ReadAll(input)
+		if len(data) == 0 {
+			return countReport{}, errors.New("empty input")
+		}
+
+		return countReport{Count: len(data)}, err
+	})
+
+	result, err := solver.Solve(strings.NewReader("abc"))
+	if err != nil {
+		t.Fatalf("solve: %v", err)
+	}
+
+	if want := (Result{Answer: 3, Diagnostics: countReport{Count: 3}}); result != want {
+		t.Errorf("got %+v, want %+v", result, want)
+	}
+
+	if result, err := solver.Solve(strings.NewReader("")); err == nil || result != (Result{}) {
+		t.Errorf("got %+v, %v, want an error without a result", result, err)
+	}
+}
+
+func TestSolverFuncHasNoDiagnostics(t *testing.T) {
+	solver := SolverFunc(func(io.Reader) (int, error) {
+		return 42, nil
+	})
+
+	result, err := solver.Solve(strings.NewReader(""))
+	if err != nil || result != (Result{Answer: 42}) {
+		t.Errorf("got %+v, %v, want just the answer", result, err)
+	}
+}
+
+func TestReported(t *testing.T) {
+	reports := 0
+	solver := Reported(func(io.Reader) (int, error) {
+		return 42, nil
+	}, func(io.Reader) (countReport, error) {
+		reports++
+		return countReport{Count: 42}, nil
+	})
+
+	result, err := solver.Solve(strings.NewReader(""))
+	if err != nil || result != (Result{Answer: 42}) || reports != 0 {
+		t.Errorf("got %+v, %v after %d reports, want just the answer", result, err, reports)
+	}
+
+	result, err = Diagnose(solver, strings.NewReader(""))
+	if err != nil || result != (Result{Answer: 42, Diagnostics: countReport{Count: 42}}) {
+		t.Errorf("got %+v, %v, want the report", result, err)
+	}
+
+	plain := SolverFunc(func(io.Reader) (int, error) {
+		return 7, nil
+	})
+
+	if result, err := Diagnose(plain, strings.NewReader("")); err != nil || result != (Result{Answer: 7}) {
+		t.Errorf("got %+v, %v, want the answer of a solver without diagnostics", result, err)
+	}
+}
+
+// TestReportsMatchAnswers checks that reports built only for diagnostics agree with plain answers on the examples.
+func TestReportsMatchAnswers(t *testing.T) {
+	for _, puzzle := range All() {
+		for part, solver := range puzzle.Parts {
+			if _, ok := solver.(Diagnoser); !ok {
+				continue
+			}
+
+			examples, err := filepath.Glob(filepath.Join(strings.ReplaceAll(puzzle.Name, "-", ""), "testdata", "*.txt"))
+			if err != nil || len(examples) == 0 {
+				t.Fatalf("day %d: no examples, %v", puzzle.Day, err)
+			}
+
+			for _, example := range examples {
+				input, err := os.ReadFile(example)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				want, wantErr := solver.Solve(bytes.NewReader(input))
+				got, err := Diagnose(solver, bytes.NewReader(input))
+
+				if (err != nil) != (wantErr != nil) || err == nil && (got.Answer != want.Answer || got.Diagnostics == nil) {
+					t.Errorf("day %d part %d on %s: got %d, %v with diagnostics, want %d, %v", puzzle.Day, part+1,
+						example, got.Answer, err, want.Answer, wantErr)
+				}
+			}
+		}
+	}
+}
+
+func TestEveryDayParses(t *testing.T) {
+	for _, puzzle := range All() {
+		if puzzle.Parse == nil {
+			t.Errorf("day %d has no parser to benchmark", puzzle.Day)
+		}
+	}
+}
@@ -1,13 +1,13 @@
-package main
+// Package sandslabs solves day 22 of Advent of Code 2023, "Sand Slabs".
+package sandslabs
 
 import (
-	"bufio"
 	"cmp"
 	"fmt"
-	"log/slog"
-	"os"
+	"io"
 	"slices"
-	"strings"
+
+	"github.com/harmlessevil/advent-of-code-2023/parse"
 )
 
 type Point3D struct {
@@ -60,10 +60,41 @@ func union[T comparable](a, b map[T]struct{}) map[T]struct{} {
 	return res
 }
 
-func runMain() error {
-	stack, err := parseStack("input.txt")
+func Part1(input io.Reader) (int, error) {
+	chainReactions, err := solve(input)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, fallen := range chainReactions {
+		if fallen == 0 {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func Part2(input io.Reader) (int, error) {
+	chainReactions, err := solve(input)
+	if err != nil {
+		return 0, err
+	}
+
+	sum := 0
+	for _, fallen := range chainReactions {
+		sum += fallen
+	}
+
+	return sum, nil
+}
+
+// solve settles the slabs and counts, for every slab, how many other slabs would fall if it was disintegrated.
+func solve(input io.Reader) ([]int, error) {
+	stack, err := ParseStack(input)
 	if err != nil {
-		return fmt.Errorf("parse stack: %w", err)
+		return nil, fmt.Errorf("parse stack: %w", err)
 	}
 
 	stack.fallSlabs()
@@ -71,35 +102,44 @@ func runMain() error {
 
 	slices.Reverse(stack.Slabs)
 
-	sum := 0
+	res := make([]int, len(stack.Slabs))
 
-	for _, slab := range stack.Slabs {
+	for i, slab := range stack.Slabs {
 		fallen := getFallen(supportGraph, slab.ID, map[int]struct{}{slab.ID: {}})
-		sum += len(fallen) - 1
+		res[i] = len(fallen) - 1
 	}
 
-	fmt.Println(sum)
-
-	return nil
+	return res, nil
 }
 
-func parseStack(filename string) (Stack, error) {
-	f, err := os.Open(filename)
-	if err != nil {
-		return Stack{}, fmt.Errorf("open input file: %w", err)
-	}
-	defer f.Close()
-
+func ParseStack(r io.Reader) (Stack, error) {
 	stack := Stack{Bricks: map[Point3D]int{}}
 
-	scanner := bufio.NewScanner(f)
+	scanner := parse.NewScanner(r)
 
 	for i := 1; scanner.Scan(); i++ {
-		line := scanner.Text()
+		fromText, toText, err := scanner.Field().Cut("~")
+		if err != nil {
+			return Stack{}, err
+		}
+
+		from, err := parsePoint3D(fromText)
+		if err != nil {
+			return Stack{}, err
+		}
 
-		points := strings.Split(line, "~")
-		from := parsePoint3D(points[0])
-		to := parsePoint3D(points[1])
+		to, err := parsePoint3D(toText)
+		if err != nil {
+			return Stack{}, err
+		}
+
+		if to.X < from.X || to.Y < from.Y || to.Z < from.Z {
+			return Stack{}, toText.Errorf("slab ends before it starts")
+		}
+
+		if (from.X != to.X && from.Y != to.Y) || (from.X != to.X && from.Z != to.Z) || (from.Y != to.Y && from.Z != to.Z) {
+			return Stack{}, toText.Errorf("slab isn't straight")
+		}
 
 		slab := Slab{
 			ID:    i,
@@ -120,6 +160,12 @@ func parseStack(filename string) (Stack, error) {
 			slab.Length = 1
 		}
 
+		for j, direction := 0, (Point3D{}); j < slab.Length; j, direction = j+1, direction.Add(slab.Direction) {
+			if id, ok := stack.Bricks[from.Add(direction)]; ok {
+				return Stack{}, scanner.Errorf(0, "slab intersects slab %d", id)
+			}
+		}
+
 		for j, direction := 0, (Point3D{}); j < slab.Length; j, direction = j+1, direction.Add(slab.Direction) {
 			stack.Bricks[from.Add(direction)] = i
 		}
@@ -134,10 +180,23 @@ func parseStack(filename string) (Stack, error) {
 	return stack, nil
 }
 
-func parsePoint3D(line string) Point3D {
+func parsePoint3D(field parse.Field) (Point3D, error) {
+	coordinates := field.Split(",")
+	if len(coordinates) != 3 {
+		return Point3D{}, field.Errorf("expected 3 coordinates, got %q", field.Text)
+	}
+
 	var res Point3D
-	_, _ = fmt.Sscanf(line, "%d,%d,%d", &res.X, &res.Y, &res.Z)
-	return res
+	for i, p := range [3]*int{&res.X, &res.Y, &res.Z} {
+		coordinate, err := coordinates[i].Int()
+		if err != nil {
+			return Point3D{}, err
+		}
+
+		*p = coordinate
+	}
+
+	return res, nil
 }
 
 func (s *Stack) fallSlabs() {
@@ -230,10 +289,3 @@ func getFallen(supportGraph SupportGraph, slab int, fallen map[int]struct{}) map
 
 	return fallen
 }
-
-func main() {
-	if err := runMain(); err != nil {
-		slog.Error("program aborted", slog.Any("error", err))
-		os.Exit(1)
-	}
-}
@@ -0,0 +1,124 @@
+package scratchcards
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/harmlessevil/advent-of-code-2023/numtheory"
+)
+
+// Overflow is the policy for copies won of cards past the last one. The puzzle promises it never happens.
+type Overflow int
+
+const (
+	OverflowError Overflow = iota // fail, the input is broken
+	OverflowClamp                 // drop copies of cards that don't exist
+)
+
+// Engine scores cards in a single pass. Copies won for the following cards are kept in a ring buffer as big as the
+// widest match count, so memory doesn't grow with the number of cards.
+type Engine struct {
+	Overflow Overflow
+
+	Points int // total of part 1
+	Cards  int // total with copies, of part 2
+
+	pending []int // copies won for the following cards, starting at head
+	head    int
+	count   int // cards added
+
+	reach   int    // index of the furthest card copies were won of
+	reacher string // ID of the card which won it
+}
+
+// CardReport is the breakdown of a card.
+type CardReport struct {
+	ID      string `json:"id"`
+	Matches int    `json:"matches"`
+	Points  int    `json:"points"`
+	Copies  int    `json:"copies"` // including the original
+}
+
+// Add scores the next card. It fails, leaving the engine as it was, if points or copies don't fit in int.
+func (e *Engine) Add(card Scratchcard) (CardReport, error) {
+	points := 0
+	if card.Matches > 0 {
+		if card.Matches >= bits.UintSize {
+			return CardReport{}, fmt.Errorf("%s is worth 2^%d points, more than fits in int", card.ID, card.Matches-1)
+		}
+
+		points = 1 << (card.Matches - 1)
+	}
+
+	totalPoints, err := numtheory.Add(int64(e.Points), int64(points))
+	if err != nil {
+		return CardReport{}, fmt.Errorf("points of %s: %w", card.ID, err)
+	}
+
+	copies := int64(1)
+	if len(e.pending) > 0 {
+		if copies, err = numtheory.Add(copies, int64(e.pending[e.head])); err != nil {
+			return CardReport{}, fmt.Errorf("copies of %s: %w", card.ID, err)
+		}
+	}
+
+	totalCards, err := numtheory.Add(int64(e.Cards), copies)
+	if err != nil {
+		return CardReport{}, fmt.Errorf("copies of %s: %w", card.ID, err)
+	}
+
+	// Copies won for the following cards are checked before changing anything. They start after the head in the ring,
+	// and slots the ring grows by are empty.
+	for i := 0; i < card.Matches && i+1 < len(e.pending); i++ {
+		if _, err := numtheory.Add(int64(e.pending[(e.head+1+i)%len(e.pending)]), copies); err != nil {
+			return CardReport{}, fmt.Errorf("copies won by %s: %w", card.ID, err)
+		}
+	}
+
+	if len(e.pending) > 0 {
+		e.pending[e.head] = 0
+		e.head = (e.head + 1) % len(e.pending)
+	}
+
+	if card.Matches > len(e.pending) {
+		e.grow(card.Matches)
+	}
+
+	for i := 0; i < card.Matches; i++ {
+		e.pending[(e.head+i)%len(e.pending)] += int(copies)
+	}
+
+	if reach := e.count + card.Matches; card.Matches > 0 && reach > e.reach {
+		e.reach, e.reacher = reach, card.ID
+	}
+
+	e.count++
+
+	e.Points = int(totalPoints)
+	e.Cards = int(totalCards)
+
+	return CardReport{
+		ID:      card.ID,
+		Matches: card.Matches,
+		Points:  points,
+		Copies:  int(copies),
+	}, nil
+}
+
+// grow resizes the ring buffer, keeping pending copies in order.
+func (e *Engine) grow(size int) {
+	pending := make([]int, size)
+	n := copy(pending, e.pending[e.head:])
+	copy(pending[n:], e.pending[:e.head])
+
+	e.pending, e.head = pending, 0
+}
+
+// Finish checks that no copies were won past the last card, unless the policy allows it.
+func (e *Engine) Finish() error {
+	if e.Overflow == OverflowError && e.reach >= e.count {
+		return fmt.Errorf("%s wins copies of %d cards past the last one", e.reacher, e.reach-e.count+1)
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+// Package scratchcards solves day 4 of Advent of Code 2023, "Scratchcards".
+package scratchcards
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/internal/lines"
+)
+
+type Scratchcard struct {
+	ID      string
+	Matches int
+}
+
+// Report is the breakdown of every card.
+type Report struct {
+	Sum   int          `json:"sum"`
+	Cards []CardReport `json:"cards"`
+}
+
+func (r Report) Answer() int {
+	return r.Sum
+}
+
+func Part1(input io.Reader) (int, error) {
+	engine, err := Score(input, Engine{}, nil)
+	return engine.Points, err
+}
+
+func Part2(input io.Reader) (int, error) {
+	engine, err := Score(input, Engine{}, nil)
+	return engine.Cards, err
+}
+
+func Part1Report(input io.Reader) (Report, error) {
+	return scoreReport(input, 1)
+}
+
+func Part2Report(input io.Reader) (Report, error) {
+	return scoreReport(input, 2)
+}
+
+func scoreReport(input io.Reader, part int) (Report, error) {
+	var report Report
+
+	engine, err := Score(input, Engine{}, func(card CardReport) {
+		slog.Debug(
+			"has winning numbers",
+			slog.String("id", card.ID),
+			slog.Int("copies", card.Copies),
+			slog.Int("matches", card.Matches),
+		)
+
+		report.Cards = append(report.Cards, card)
+	})
+	if err != nil {
+		return Report{}, err
+	}
+
+	report.Sum = engine.Points
+	if part == 2 {
+		report.Sum = engine.Cards
+	}
+
+	return report, nil
+}
+
+// Score runs the engine over the cards of the input, passing the breakdown of every card to visit if it's not nil.
+// Cards are matched in parallel, but copies depend on previous cards, so they are scored in order.
+func Score(input io.Reader, engine Engine, visit func(CardReport)) (Engine, error) {
+	err := lines.Process(input, lines.Options{}, func(line parse.Field) (Scratchcard, error) {
+		card, err := parseScratchcard(line)
+		if err != nil {
+			return Scratchcard{}, fmt.Errorf("parse scratchcard: %w", err)
+		}
+
+		return card, nil
+	}, func(card Scratchcard) error {
+		report, err := engine.Add(card)
+		if err != nil {
+			return err
+		}
+
+		if visit != nil {
+			visit(report)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Engine{}, err
+	}
+
+	if err := engine.Finish(); err != nil {
+		return Engine{}, err
+	}
+
+	return engine, nil
+}
+
+func ParseScratchcards(r io.Reader) ([]Scratchcard, error) {
+	var cards []Scratchcard
+
+	scanner := parse.NewScanner(r)
+	for scanner.Scan() {
+		card, err := parseScratchcard(scanner.Field())
+		if err != nil {
+			return nil, fmt.Errorf("parse scratchcard: %w", err)
+		}
+
+		cards = append(cards, card)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan: %w", err)
+	}
+
+	return cards, nil
+}
+
+func parseScratchcard(line parse.Field) (Scratchcard, error) {
+	id, numbers, err := line.Cut(": ")
+	if err != nil {
+		return Scratchcard{}, err
+	}
+
+	winning, picked, err := numbers.Cut(" | ")
+	if err != nil {
+		return Scratchcard{}, err
+	}
+
+	winningNumbers, err := winning.Ints()
+	if err != nil {
+		return Scratchcard{}, fmt.Errorf("parse winning numbers: %w", err)
+	}
+
+	pickedNumbers, err := picked.Ints()
+	if err != nil {
+		return Scratchcard{}, fmt.Errorf("parse picked numbers: %w", err)
+	}
+
+	numbersMatchedAmount := 0
+	for _, number := range pickedNumbers {
+		if slices.Contains(winningNumbers, number) {
+			numbersMatchedAmount++
+		}
+	}
+
+	return Scratchcard{
+		ID:      id.Text,
+		Matches: numbersMatchedAmount,
+	}, nil
+}
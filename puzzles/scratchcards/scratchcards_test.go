@@ -0,0 +1,143 @@
+package scratchcards
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/harmlessevil/advent-of-code-2023/numtheory"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/internal/puzzletest"
+)
+
+func TestExamples(t *testing.T) {
+	puzzletest.Run(t, []puzzletest.Case{
+		{
+			Name:  "part 1",
+			Solve: Part1,
+			Input: "testdata/example.txt",
+			Want:  13,
+		},
+		{
+			Name:  "part 2",
+			Solve: Part2,
+			Input: "testdata/example.txt",
+			Want:  30,
+		},
+	})
+}
+
+func BenchmarkParse(b *testing.B) {
+	puzzletest.Benchmark(b, 4, "testdata/example.txt", ParseScratchcards)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	puzzletest.Benchmark(b, 4, "testdata/example.txt", Part1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	puzzletest.Benchmark(b, 4, "testdata/example.txt", Part2)
+}
+
+func TestReport(t *testing.T) {
+	input, err := os.ReadFile("testdata/example.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := Part2Report(bytes.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []CardReport{
+		{ID: "Card 1", Matches: 4, Points: 8, Copies: 1},
+		{ID: "Card 2", Matches: 2, Points: 2, Copies: 2},
+		{ID: "Card 3", Matches: 2, Points: 2, Copies: 4},
+		{ID: "Card 4", Matches: 1, Points: 1, Copies: 8},
+		{ID: "Card 5", Matches: 0, Points: 0, Copies: 14},
+		{ID: "Card 6", Matches: 0, Points: 0, Copies: 1},
+	}
+
+	if report.Sum != 30 || !slices.Equal(report.Cards, want) {
+		t.Errorf("got %+v, want sum 30 and cards %+v", report, want)
+	}
+}
+
+func TestOverflow(t *testing.T) {
+	input := "Card 1: 1 2 | 3 4\nCard 2: 1 2 3 | 1 2 3\nCard 3: 5 | 6\n"
+
+	if _, err := Part2(strings.NewReader(input)); err == nil || !strings.Contains(err.Error(), "Card 2 wins copies of 2 cards") {
+		t.Errorf("got error %v, want one about copies past the last card", err)
+	}
+
+	engine, err := Score(strings.NewReader(input), Engine{Overflow: OverflowClamp}, nil)
+	if err != nil || engine.Cards != 4 || engine.Points != 4 {
+		t.Errorf("got %d cards and %d points, %v, want 4 and 4 with copies past the last card dropped", engine.Cards,
+			engine.Points, err)
+	}
+}
+
+func TestEngineGrowsRing(t *testing.T) {
+	var e Engine
+	for i, matches := range []int{1, 3, 0, 2, 0, 0} {
+		if _, err := e.Add(Scratchcard{ID: fmt.Sprint(i + 1), Matches: matches}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Copies: 1, 2, 3, 3, 6, 4.
+	if err := e.Finish(); err != nil || e.Cards != 19 {
+		t.Errorf("got %d cards, %v, want 19", e.Cards, err)
+	}
+}
+
+func TestEnginePointsOverflow(t *testing.T) {
+	e := Engine{Overflow: OverflowClamp}
+
+	report, err := e.Add(Scratchcard{ID: "Card 1", Matches: 63})
+	if err != nil || report.Points != 1<<62 {
+		t.Fatalf("got %+v, %v, want 2^62 points", report, err)
+	}
+
+	for _, matches := range []int{63, 64, 100} {
+		if _, err := e.Add(Scratchcard{ID: "Card 2", Matches: matches}); err == nil {
+			t.Errorf("%d matches: got no error", matches)
+		}
+	}
+
+	if e.Points != 1<<62 || e.Cards != 1 {
+		t.Errorf("got %d points and %d cards, want the engine unchanged after errors", e.Points, e.Cards)
+	}
+}
+
+func TestEngineCopiesOverflow(t *testing.T) {
+	e := Engine{Overflow: OverflowClamp}
+
+	// Every card wins copies of all the following ones up to the last, so the k-th card has 2^(k-1) copies.
+	for i := 1; i < bits.UintSize; i++ {
+		if _, err := e.Add(Scratchcard{ID: fmt.Sprint(i), Matches: bits.UintSize - i}); err != nil {
+			t.Fatalf("card %d: %v", i, err)
+		}
+	}
+
+	if e.Cards != math.MaxInt {
+		t.Fatalf("got %d cards, want %d", e.Cards, math.MaxInt)
+	}
+
+	_, err := e.Add(Scratchcard{ID: "last"})
+
+	var overflowErr *numtheory.OverflowError
+	if !errors.As(err, &overflowErr) {
+		t.Errorf("got %v, want an overflow", err)
+	}
+
+	if e.Cards != math.MaxInt || e.count != bits.UintSize-1 {
+		t.Errorf("got %d cards after %d added, want the engine unchanged after the error", e.Cards, e.count)
+	}
+}
@@ -1,27 +1,65 @@
-package main
+// Package snowverload solves day 25 of Advent of Code 2023, "Snowverload".
+package snowverload
 
 import (
-	"bufio"
 	"fmt"
+	"io"
 	"log/slog"
-	"os"
-	"strings"
+
+	"github.com/harmlessevil/advent-of-code-2023/parse"
 )
 
 type Wiring map[string]map[string]struct{}
 
-func runMain() error {
-	wiring, err := parseWiring("input.txt")
+// Report is the cut of 3 wires and sizes of the groups it splits components into.
+type Report struct {
+	Cut    []Edge `json:"cut"`
+	Groups [2]int `json:"groups"`
+}
+
+func (r Report) Answer() int {
+	return r.Groups[0] * r.Groups[1]
+}
+
+func Part1(input io.Reader) (int, error) {
+	report, err := Part1Report(input)
+	return report.Answer(), err
+}
+
+func Part1Report(input io.Reader) (Report, error) {
+	wiring, err := ParseWiring(input)
 	if err != nil {
-		return fmt.Errorf("parse wiring: %w", err)
+		return Report{}, fmt.Errorf("parse wiring: %w", err)
 	}
 
+	if len(wiring) == 0 {
+		return Report{}, fmt.Errorf("no components")
+	}
+
+	for from := range wiring {
+		if count := dfs(wiring, map[string]struct{}{from: {}}, from); count != len(wiring) {
+			return Report{}, fmt.Errorf("components are already split into groups")
+		}
+
+		break
+	}
+
+	// Every contraction finds some cut, so the minimum cut is never larger than the cut found. The number of attempts
+	// is bounded in case there is no cut of 3 wires at all: Karger's algorithm finds a minimum cut after n^2 attempts
+	// with a high probability.
 	var cut []Edge
-	for len(cut) != 3 {
+	for attempt := 0; len(cut) != 3; attempt++ {
+		if attempt == len(wiring)*len(wiring) {
+			return Report{}, fmt.Errorf("no cut of 3 wires found")
+		}
+
 		cut = contract(wiring)
+		if len(cut) < 3 {
+			return Report{}, fmt.Errorf("components are split by %d wires, expected 3", len(cut))
+		}
 	}
 
-	fmt.Println(cut)
+	slog.Debug("found cut", slog.Any("edges", cut))
 
 	for _, edge := range cut {
 		delete(wiring[edge.From], edge.To)
@@ -33,28 +71,41 @@ func runMain() error {
 	count1 := dfs(wiring, map[string]struct{}{edge.From: {}}, edge.From)
 	count2 := dfs(wiring, map[string]struct{}{edge.To: {}}, edge.To)
 
-	fmt.Println(count1, count2, count1*count2)
+	slog.Debug("split groups", slog.Int("first", count1), slog.Int("second", count2))
 
-	return nil
+	return Report{
+		Cut:    cut,
+		Groups: [2]int{count1, count2},
+	}, nil
 }
 
-func parseWiring(filename string) (Wiring, error) {
-	f, err := os.Open(filename)
-	if err != nil {
-		return nil, fmt.Errorf("open input file: %w", err)
-	}
-	defer f.Close()
-
+func ParseWiring(r io.Reader) (Wiring, error) {
 	wiring := Wiring{}
 
-	scanner := bufio.NewScanner(f)
+	scanner := parse.NewScanner(r)
 
 	for scanner.Scan() {
-		line := scanner.Text()
+		fromText, toText, err := scanner.Field().Cut(": ")
+		if err != nil {
+			return nil, err
+		}
+
+		from := fromText.Text
+		if from == "" {
+			return nil, fromText.Errorf("expected component name")
+		}
+
+		toFields := toText.Fields()
+		if len(toFields) == 0 {
+			return nil, toText.Errorf("expected connected components")
+		}
 
-		from := line[:3]
+		for _, toField := range toFields {
+			to := toField.Text
+			if to == from {
+				return nil, toField.Errorf("component %s is connected to itself", to)
+			}
 
-		for _, to := range strings.Split(line[5:], " ") {
 			if _, ok := wiring[from]; !ok {
 				wiring[from] = map[string]struct{}{}
 			}
@@ -75,8 +126,8 @@ func parseWiring(filename string) (Wiring, error) {
 }
 
 type Edge struct {
-	From string
-	To   string
+	From string `json:"from"`
+	To   string `json:"to"`
 }
 
 func contract(wiring Wiring) []Edge {
@@ -165,10 +216,3 @@ func dfs(wiring Wiring, visited map[string]struct{}, vertex string) int {
 
 	return res
 }
-
-func main() {
-	if err := runMain(); err != nil {
-		slog.Error("program aborted", slog.Any("error", err))
-		os.Exit(1)
-	}
-}
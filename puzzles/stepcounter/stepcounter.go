@@ -0,0 +1,133 @@
+// Package stepcounter solves day 21 of Advent of Code 2023, "Step Counter".
+package stepcounter
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/harmlessevil/advent-of-code-2023/grid"
+)
+
+type Tile int
+
+const (
+	TileGardenPlot Tile = iota
+	TileRock
+)
+
+type GardenMap struct {
+	Tiles grid.Grid[Tile]
+	Start grid.Point
+}
+
+func f(x int, a0, a1, a2 int) int {
+	b0 := a0
+	b1 := a1 - a0
+	b2 := a2 - a1
+
+	return b0 + b1*x + (x*(x-1)/2)*(b2-b1)
+}
+
+const (
+	Part1Steps = 64
+	Part2Steps = 26_501_365
+)
+
+func Part1(input io.Reader) (int, error) {
+	gardenMap, err := ParseGardenMap(input)
+	if err != nil {
+		return 0, fmt.Errorf("parse garden map: %w", err)
+	}
+
+	return bfs(gardenMap, []int{Part1Steps})[0], nil
+}
+
+func Part2(input io.Reader) (int, error) {
+	gardenMap, err := ParseGardenMap(input)
+	if err != nil {
+		return 0, fmt.Errorf("parse garden map: %w", err)
+	}
+
+	// The number of reachable plots grows quadratically every time the steps reach one more copy of the map, which
+	// holds for a square map with the start in the center.
+	size := gardenMap.Tiles.Height
+	if gardenMap.Tiles.Width != size || gardenMap.Start != (grid.Point{X: size / 2, Y: size / 2}) {
+		return 0, fmt.Errorf("expected a square map with the start in the center")
+	}
+
+	remainder := Part2Steps % size
+	coefficients := bfs(gardenMap, []int{remainder, remainder + size, remainder + 2*size})
+	return f(Part2Steps/size, coefficients[0], coefficients[1], coefficients[2]), nil
+}
+
+func ParseGardenMap(r io.Reader) (GardenMap, error) {
+	var gardenMap GardenMap
+	hasStart := false
+
+	tiles, err := grid.Parse(r, func(point grid.Point, symbol rune) (Tile, error) {
+		switch symbol {
+		case '.':
+			return TileGardenPlot, nil
+		case '#':
+			return TileRock, nil
+		case 'S':
+			if hasStart {
+				return 0, fmt.Errorf("second start, the first one is at %d:%d", gardenMap.Start.Y+1, gardenMap.Start.X+1)
+			}
+
+			gardenMap.Start = point
+			hasStart = true
+			return TileGardenPlot, nil
+		}
+
+		return 0, fmt.Errorf("unknown tile")
+	})
+	if err != nil {
+		return GardenMap{}, err
+	}
+
+	if !hasStart {
+		return GardenMap{}, fmt.Errorf("no start")
+	}
+
+	gardenMap.Tiles = tiles
+
+	return gardenMap, nil
+}
+
+// bfs returns the number of plots reachable in exactly the given numbers of steps, which must be increasing.
+func bfs(gardenMap GardenMap, steps []int) []int {
+	front := []grid.Point{gardenMap.Start}
+
+	res := make([]int, len(steps))
+
+	for i, j := 0, 0; ; i++ {
+		if i == steps[j] {
+			res[j] = len(front)
+
+			if j++; j == len(steps) {
+				return res
+			}
+		}
+
+		var nextFront []grid.Point
+		visited := map[grid.Point]struct{}{}
+
+		for _, point := range front {
+			for _, nextPoint := range point.Neighbors4() {
+				if _, ok := visited[nextPoint]; ok {
+					continue
+				}
+
+				if gardenMap.Tiles.AtWrapped(nextPoint) == TileRock {
+					continue
+				}
+
+				visited[nextPoint] = struct{}{}
+				nextFront = append(nextFront, nextPoint)
+			}
+		}
+
+		front = nextFront
+	}
+}
@@ -0,0 +1,61 @@
+package stepcounter
+
+import (
+	"io"
+	"testing"
+
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/internal/puzzletest"
+)
+
+// Part 2 relies on the shape of real inputs, so the example checks the walk over the infinite map instead.
+func TestExamples(t *testing.T) {
+	walk := func(steps int) func(input io.Reader) (int, error) {
+		return func(input io.Reader) (int, error) {
+			gardenMap, err := ParseGardenMap(input)
+			if err != nil {
+				return 0, err
+			}
+
+			return bfs(gardenMap, []int{steps})[0], nil
+		}
+	}
+
+	puzzletest.Run(t, []puzzletest.Case{
+		{
+			Name:  "part 1",
+			Solve: walk(6),
+			Input: "testdata/example.txt",
+			Want:  16,
+		},
+		{
+			Name:  "infinite map 10 steps",
+			Solve: walk(10),
+			Input: "testdata/example.txt",
+			Want:  50,
+		},
+		{
+			Name:  "infinite map 50 steps",
+			Solve: walk(50),
+			Input: "testdata/example.txt",
+			Want:  1594,
+		},
+		{
+			Name:  "infinite map 100 steps",
+			Solve: walk(100),
+			Input: "testdata/example.txt",
+			Want:  6536,
+		},
+	})
+}
+
+func BenchmarkParse(b *testing.B) {
+	puzzletest.Benchmark(b, 21, "testdata/example.txt", ParseGardenMap)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	puzzletest.Benchmark(b, 21, "testdata/example.txt", Part1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	puzzletest.Benchmark(b, 21, "testdata/example.txt", Part2)
+}
@@ -0,0 +1,211 @@
+// Package thefloorwillbelava solves day 16 of Advent of Code 2023, "The Floor Will Be Lava".
+package thefloorwillbelava
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/harmlessevil/advent-of-code-2023/grid"
+)
+
+type Tile int
+
+const (
+	TileEmptySpace Tile = iota
+	TileMirrorForward
+	TileMirrorBack
+	TileSplitterVertical
+	TileSplitterHorizontal
+)
+
+type Contraption = grid.Grid[Tile]
+
+func Part1(input io.Reader) (int, error) {
+	contraption, err := ParseContraption(input)
+	if err != nil {
+		return 0, fmt.Errorf("parse contraption: %w", err)
+	}
+
+	visitStatus := simulateLightBeam(contraption, BFSPoint{Direction: grid.DirectionRight})
+
+	return countEnergized(visitStatus), nil
+}
+
+func Part2(input io.Reader) (int, error) {
+	contraption, err := ParseContraption(input)
+	if err != nil {
+		return 0, fmt.Errorf("parse contraption: %w", err)
+	}
+
+	var maxEnergised int
+
+	for i := 0; i < contraption.Height; i++ {
+		visitStatus := simulateLightBeam(contraption, BFSPoint{
+			Point: grid.Point{
+				X: 0,
+				Y: i,
+			},
+			Direction: grid.DirectionRight,
+		})
+
+		maxEnergised = max(maxEnergised, countEnergized(visitStatus))
+	}
+
+	for i := 0; i < contraption.Height; i++ {
+		visitStatus := simulateLightBeam(contraption, BFSPoint{
+			Point: grid.Point{
+				X: contraption.Width - 1,
+				Y: i,
+			},
+			Direction: grid.DirectionLeft,
+		})
+
+		maxEnergised = max(maxEnergised, countEnergized(visitStatus))
+	}
+
+	for j := 0; j < contraption.Width; j++ {
+		visitStatus := simulateLightBeam(contraption, BFSPoint{
+			Point: grid.Point{
+				X: j,
+				Y: 0,
+			},
+			Direction: grid.DirectionDown,
+		})
+
+		maxEnergised = max(maxEnergised, countEnergized(visitStatus))
+	}
+
+	for j := 0; j < contraption.Width; j++ {
+		visitStatus := simulateLightBeam(contraption, BFSPoint{
+			Point: grid.Point{
+				X: j,
+				Y: contraption.Height - 1,
+			},
+			Direction: grid.DirectionUp,
+		})
+
+		maxEnergised = max(maxEnergised, countEnergized(visitStatus))
+	}
+
+	return maxEnergised, nil
+}
+
+func ParseContraption(r io.Reader) (Contraption, error) {
+	return grid.Parse(r, func(_ grid.Point, symbol rune) (Tile, error) {
+		switch symbol {
+		case '.':
+			return TileEmptySpace, nil
+		case '/':
+			return TileMirrorForward, nil
+		case '\\':
+			return TileMirrorBack, nil
+		case '|':
+			return TileSplitterVertical, nil
+		case '-':
+			return TileSplitterHorizontal, nil
+		}
+
+		return 0, fmt.Errorf("unknown tile")
+	})
+}
+
+type VisitStatus int
+
+const (
+	VisitStatusNotVisited VisitStatus = iota
+	VisitStatusPendingVisit
+	VisitStatusVisited
+)
+
+// mirrorForward reflects a beam moving in the direction d off the / mirror.
+func mirrorForward(d grid.Direction) grid.Direction {
+	return map[grid.Direction]grid.Direction{
+		grid.DirectionRight: grid.DirectionUp,
+		grid.DirectionDown:  grid.DirectionLeft,
+		grid.DirectionLeft:  grid.DirectionDown,
+		grid.DirectionUp:    grid.DirectionRight,
+	}[d]
+}
+
+// mirrorBack reflects a beam moving in the direction d off the \ mirror.
+func mirrorBack(d grid.Direction) grid.Direction {
+	return map[grid.Direction]grid.Direction{
+		grid.DirectionRight: grid.DirectionDown,
+		grid.DirectionDown:  grid.DirectionRight,
+		grid.DirectionLeft:  grid.DirectionUp,
+		grid.DirectionUp:    grid.DirectionLeft,
+	}[d]
+}
+
+type BeamVisitStatus struct {
+	Dirs [4]VisitStatus
+}
+
+func (s BeamVisitStatus) IsEnergized() bool {
+	return s.Dirs[grid.DirectionRight] == VisitStatusVisited ||
+		s.Dirs[grid.DirectionDown] == VisitStatusVisited ||
+		s.Dirs[grid.DirectionLeft] == VisitStatusVisited ||
+		s.Dirs[grid.DirectionUp] == VisitStatusVisited
+}
+
+type BFSPoint struct {
+	Point     grid.Point
+	Direction grid.Direction
+}
+
+func (p BFSPoint) Move(direction grid.Direction) BFSPoint {
+	return BFSPoint{
+		Point:     p.Point.Add(direction.ToVector()),
+		Direction: direction,
+	}
+}
+
+func simulateLightBeam(contraption Contraption, start BFSPoint) grid.Grid[BeamVisitStatus] {
+	visitStatus := grid.New[BeamVisitStatus](contraption.Width, contraption.Height)
+
+	for points := []BFSPoint{start}; len(points) > 0; points = points[1:] {
+		point := points[0]
+
+		visitStatus.Ref(point.Point).Dirs[point.Direction] = VisitStatusVisited
+
+		nextPoints := make([]BFSPoint, 0, 2)
+		switch contraption.At(point.Point) {
+		case TileEmptySpace:
+			nextPoints = append(nextPoints, point.Move(point.Direction))
+		case TileSplitterVertical:
+			nextPoints = append(nextPoints, point.Move(grid.DirectionUp), point.Move(grid.DirectionDown))
+		case TileSplitterHorizontal:
+			nextPoints = append(nextPoints, point.Move(grid.DirectionLeft), point.Move(grid.DirectionRight))
+		case TileMirrorForward:
+			nextPoints = append(nextPoints, point.Move(mirrorForward(point.Direction)))
+		case TileMirrorBack:
+			nextPoints = append(nextPoints, point.Move(mirrorBack(point.Direction)))
+		}
+
+		for _, nextPoint := range nextPoints {
+			if !contraption.InBounds(nextPoint.Point) {
+				continue
+			}
+
+			if nextPointVisitStatus := visitStatus.Ref(nextPoint.Point); nextPointVisitStatus.Dirs[nextPoint.Direction] == VisitStatusNotVisited {
+				nextPointVisitStatus.Dirs[nextPoint.Direction] = VisitStatusPendingVisit
+
+				points = append(points, nextPoint)
+			}
+		}
+	}
+
+	return visitStatus
+}
+
+func countEnergized(visitStatus grid.Grid[BeamVisitStatus]) int {
+	count := 0
+
+	for _, point := range visitStatus.Points() {
+		if visitStatus.At(point).IsEnergized() {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,155 @@
+package trebuchet
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Vocabulary maps tokens to the digits they stand for.
+type Vocabulary map[string]int
+
+var (
+	Digits  = Vocabulary{"1": 1, "2": 2, "3": 3, "4": 4, "5": 5, "6": 6, "7": 7, "8": 8, "9": 9}
+	English = Spelled("one", "two", "three", "four", "five", "six", "seven", "eight", "nine")
+)
+
+// Spelled returns a vocabulary of digits and words spelling them out, where i-th word stands for digit i+1.
+func Spelled(words ...string) Vocabulary {
+	vocabulary := make(Vocabulary, len(Digits)+len(words))
+	for token, digit := range Digits {
+		vocabulary[token] = digit
+	}
+
+	for i, word := range words {
+		vocabulary[word] = i + 1
+	}
+
+	return vocabulary
+}
+
+type token struct {
+	length int
+	value  int
+}
+
+// Matcher finds tokens of a vocabulary in a single pass over a line, using the Aho-Corasick automaton. Tokens may
+// overlap, so "eightwo" has both 8 and 2.
+type Matcher struct {
+	classes [256]int // bytes used in tokens are numbered from 1, the rest are 0
+	width   int      // number of classes
+	next    []int    // state after reading a byte of the class, at state*width+class
+	outputs [][]token
+}
+
+func NewMatcher(vocabulary Vocabulary) (*Matcher, error) {
+	if len(vocabulary) == 0 {
+		return nil, errors.New("empty vocabulary")
+	}
+
+	m := &Matcher{width: 1}
+
+	for word, value := range vocabulary {
+		if word == "" {
+			return nil, errors.New("empty token")
+		}
+
+		if value < 0 || value > 9 {
+			return nil, fmt.Errorf("token %q stands for %d, not a digit", word, value)
+		}
+
+		for i := 0; i < len(word); i++ {
+			if m.classes[word[i]] == 0 {
+				m.classes[word[i]] = m.width
+				m.width++
+			}
+		}
+	}
+
+	root := m.addState()
+
+	for word, value := range vocabulary {
+		state := root
+		for i := 0; i < len(word); i++ {
+			edge := state*m.width + m.classes[word[i]]
+			if m.next[edge] == -1 {
+				m.next[edge] = m.addState()
+			}
+
+			state = m.next[edge]
+		}
+
+		m.outputs[state] = append(m.outputs[state], token{length: len(word), value: value})
+	}
+
+	// Missing edges lead where the longest suffix that is a prefix of a token does, computed breadth first, so states
+	// of shorter prefixes are done before.
+	fail := make([]int, len(m.outputs))
+	var queue []int
+
+	for class := 0; class < m.width; class++ {
+		if child := m.next[class]; child == -1 {
+			m.next[class] = root
+		} else {
+			queue = append(queue, child)
+		}
+	}
+
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+
+		m.outputs[state] = append(m.outputs[state], m.outputs[fail[state]]...)
+
+		for class := 0; class < m.width; class++ {
+			edge := state*m.width + class
+			fallback := m.next[fail[state]*m.width+class]
+
+			if m.next[edge] == -1 {
+				m.next[edge] = fallback
+			} else {
+				fail[m.next[edge]] = fallback
+				queue = append(queue, m.next[edge])
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (m *Matcher) addState() int {
+	for class := 0; class < m.width; class++ {
+		m.next = append(m.next, -1)
+	}
+
+	m.outputs = append(m.outputs, nil)
+
+	return len(m.outputs) - 1
+}
+
+// Find returns the tokens starting first and last in the line. Of tokens starting at the same index, the longest is
+// taken. It's not ok if the line has no tokens.
+func (m *Matcher) Find(line string) (first, last Match, ok bool) {
+	first = Match{Index: len(line)}
+	last = Match{Index: -1}
+
+	state := 0
+	for i := 0; i < len(line); i++ {
+		state = m.next[state*m.width+m.classes[line[i]]]
+
+		// Tokens ending at the same index start at different ones, and a longer token starting at some index ends
+		// after shorter ones, so it replaces them.
+		for _, t := range m.outputs[state] {
+			start := i - t.length + 1
+
+			if start <= first.Index {
+				first = Match{Index: start, Value: t.value}
+			}
+
+			if start >= last.Index {
+				last = Match{Index: start, Value: t.value}
+			}
+		}
+	}
+
+	return first, last, last.Index != -1
+}
@@ -0,0 +1,82 @@
+// Package trebuchet solves day 1 of Advent of Code 2023, "Trebuchet?!".
+package trebuchet
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/internal/lines"
+)
+
+type Match struct {
+	Index int
+	Value int
+}
+
+var (
+	digitsMatcher  = mustNewMatcher(Digits)
+	englishMatcher = mustNewMatcher(English)
+)
+
+func mustNewMatcher(vocabulary Vocabulary) *Matcher {
+	m, err := NewMatcher(vocabulary)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+func Part1(input io.Reader) (int, error) {
+	return solve(input, digitsMatcher)
+}
+
+func Part2(input io.Reader) (int, error) {
+	return solve(input, englishMatcher)
+}
+
+// Sum adds up calibration values of the lines, with digits written as tokens of the vocabulary.
+func Sum(input io.Reader, vocabulary Vocabulary) (int, error) {
+	m, err := NewMatcher(vocabulary)
+	if err != nil {
+		return 0, err
+	}
+
+	return solve(input, m)
+}
+
+// solve adds up calibration values, splitting large inputs between goroutines.
+func solve(input io.Reader, m *Matcher) (int, error) {
+	return lines.Sum(input, lines.Options{}, func(line parse.Field) (int, error) {
+		value, ok := calibrationValue(line.Text, m)
+		if !ok {
+			return 0, parse.Errorf(line.Line, 0, "no digits in %q", line.Text)
+		}
+
+		return value, nil
+	})
+}
+
+// ParseDocument reads lines of the calibration document. Digits are only found while solving, as they are written
+// differently in both parts.
+func ParseDocument(r io.Reader) ([]string, error) {
+	var document []string
+
+	scanner := parse.NewScanner(r)
+	for scanner.Scan() {
+		document = append(document, scanner.Text())
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan: %w", err)
+	}
+
+	return document, nil
+}
+
+// calibrationValue combines the first and the last digit in the line. It's not ok if the line has no digits.
+func calibrationValue(line string, m *Matcher) (int, bool) {
+	first, last, ok := m.Find(line)
+	return first.Value*10 + last.Value, ok
+}
@@ -0,0 +1,135 @@
+package trebuchet
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/internal/puzzletest"
+)
+
+func TestExamples(t *testing.T) {
+	puzzletest.Run(t, []puzzletest.Case{
+		{
+			Name:  "part 1",
+			Solve: Part1,
+			Input: "testdata/example1.txt",
+			Want:  142,
+		},
+		{
+			Name:  "part 2",
+			Solve: Part2,
+			Input: "testdata/example2.txt",
+			Want:  281,
+		},
+	})
+}
+
+func BenchmarkParse(b *testing.B) {
+	puzzletest.Benchmark(b, 1, "testdata/example1.txt", ParseDocument)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	puzzletest.Benchmark(b, 1, "testdata/example1.txt", Part1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	puzzletest.Benchmark(b, 1, "testdata/example2.txt", Part2)
+}
+
+func TestMatcher(t *testing.T) {
+	german, err := NewMatcher(Spelled("eins", "zwei", "drei", "vier", "fünf", "sechs", "sieben", "acht", "neun"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		matcher     *Matcher
+		line        string
+		first, last Match
+	}{
+		{matcher: englishMatcher, line: "eightwo", first: Match{Index: 0, Value: 8}, last: Match{Index: 4, Value: 2}},
+		{matcher: englishMatcher, line: "xtwone3four", first: Match{Index: 1, Value: 2}, last: Match{Index: 7, Value: 4}},
+		{matcher: englishMatcher, line: "oneight", first: Match{Index: 0, Value: 1}, last: Match{Index: 2, Value: 8}},
+		{matcher: englishMatcher, line: "sevenine", first: Match{Index: 0, Value: 7}, last: Match{Index: 4, Value: 9}},
+		{matcher: englishMatcher, line: "nnineeight", first: Match{Index: 1, Value: 9}, last: Match{Index: 5, Value: 8}},
+		{matcher: digitsMatcher, line: "eightwo3", first: Match{Index: 7, Value: 3}, last: Match{Index: 7, Value: 3}},
+		{matcher: german, line: "achtzweiundfünfzig", first: Match{Index: 0, Value: 8}, last: Match{Index: 11, Value: 5}},
+		{matcher: german, line: "x7neunsieben", first: Match{Index: 1, Value: 7}, last: Match{Index: 6, Value: 7}},
+	}
+
+	for _, c := range cases {
+		first, last, ok := c.matcher.Find(c.line)
+		if !ok || first != c.first || last != c.last {
+			t.Errorf("%q: got %+v and %+v, want %+v and %+v", c.line, first, last, c.first, c.last)
+		}
+	}
+
+	if _, _, ok := englishMatcher.Find("abcdefg"); ok {
+		t.Errorf("found digits in a line without them")
+	}
+}
+
+// TestMatcherAgainstIndex compares the automaton to looking for every token in random lines.
+func TestMatcherAgainstIndex(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 10000; i++ {
+		line := make([]byte, rng.Intn(20))
+		for j := range line {
+			line[j] = "onetwhrfuivsxg1eacnl"[rng.Intn(20)]
+		}
+
+		wantFirst, wantLast := Match{Index: len(line)}, Match{Index: -1}
+		for word, value := range English {
+			if j := strings.Index(string(line), word); j != -1 && j < wantFirst.Index {
+				wantFirst = Match{Index: j, Value: value}
+			}
+
+			if j := strings.LastIndex(string(line), word); j > wantLast.Index {
+				wantLast = Match{Index: j, Value: value}
+			}
+		}
+
+		first, last, ok := englishMatcher.Find(string(line))
+		if ok != (wantLast.Index != -1) || ok && (first != wantFirst || last != wantLast) {
+			t.Fatalf("%q: got %+v and %+v, want %+v and %+v", line, first, last, wantFirst, wantLast)
+		}
+	}
+}
+
+func TestNewMatcherErrors(t *testing.T) {
+	for _, vocabulary := range []Vocabulary{{}, {"": 1}, {"ten": 10}} {
+		if _, err := NewMatcher(vocabulary); err == nil {
+			t.Errorf("got no error for %v", vocabulary)
+		}
+	}
+}
+
+// TestLargeInput checks that splitting input between goroutines gives the same sum as reading it line by line.
+func TestLargeInput(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	var b strings.Builder
+	want := 0
+	for b.Len() < 3<<20 {
+		line := make([]byte, 1+rng.Intn(40))
+		for j := range line {
+			line[j] = "onetwhrfuivsxg123456789"[rng.Intn(23)]
+		}
+
+		value, ok := calibrationValue(string(line), englishMatcher)
+		if !ok {
+			continue
+		}
+
+		want += value
+		b.Write(line)
+		b.WriteByte('\n')
+	}
+
+	got, err := Part2(strings.NewReader(b.String()))
+	if err != nil || got != want {
+		t.Errorf("got %d, %v, want %d", got, err, want)
+	}
+}
@@ -0,0 +1,160 @@
+// Package waitforit solves day 6 of Advent of Code 2023, "Wait For It".
+package waitforit
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/harmlessevil/advent-of-code-2023/parse"
+)
+
+type Race struct {
+	Time     int
+	Distance int
+}
+
+func Part1(input io.Reader) (int, error) {
+	races, err := ParseRaces(input)
+	if err != nil {
+		return 0, fmt.Errorf("parse races: %w", err)
+	}
+
+	res := 1
+	for _, race := range races {
+		res *= race.Winning().Count()
+	}
+
+	return res, nil
+}
+
+func Part2(input io.Reader) (int, error) {
+	races, err := ParseRaces(input)
+	if err != nil {
+		return 0, fmt.Errorf("parse races: %w", err)
+	}
+
+	race, err := kern(races)
+	if err != nil {
+		return 0, fmt.Errorf("kern races: %w", err)
+	}
+
+	return race.Winning().Count(), nil
+}
+
+func ParseRaces(r io.Reader) ([]Race, error) {
+	scanner := parse.NewScanner(r)
+
+	times, err := parseNumbers(scanner, "Time:")
+	if err != nil {
+		return nil, fmt.Errorf("parse times: %w", err)
+	}
+
+	distances, err := parseNumbers(scanner, "Distance:")
+	if err != nil {
+		return nil, fmt.Errorf("parse distances: %w", err)
+	}
+
+	if len(times) != len(distances) {
+		return nil, scanner.Errorf(0, "got %d times and %d distances", len(times), len(distances))
+	}
+
+	races := make([]Race, len(times))
+	for i := range races {
+		if times[i] < 0 || distances[i] < 0 {
+			return nil, fmt.Errorf("race %d has negative time %d or distance %d", i+1, times[i], distances[i])
+		}
+
+		races[i] = Race{
+			Time:     times[i],
+			Distance: distances[i],
+		}
+	}
+
+	return races, nil
+}
+
+// parseNumbers reads the next line of numbers after the label.
+func parseNumbers(scanner *parse.Scanner, label string) ([]int, error) {
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		return nil, scanner.Errorf(0, "expected %q, got end of input", label)
+	}
+
+	numbers, err := scanner.Field().TrimPrefix(label)
+	if err != nil {
+		return nil, err
+	}
+
+	return numbers.Ints()
+}
+
+// kern joins the races into one, ignoring the spaces between their numbers.
+func kern(races []Race) (Race, error) {
+	var time, distance strings.Builder
+	for _, race := range races {
+		time.WriteString(strconv.Itoa(race.Time))
+		distance.WriteString(strconv.Itoa(race.Distance))
+	}
+
+	t, err := strconv.Atoi(time.String())
+	if err != nil {
+		return Race{}, fmt.Errorf("parse time %q: %w", time.String(), err)
+	}
+
+	d, err := strconv.Atoi(distance.String())
+	if err != nil {
+		return Race{}, fmt.Errorf("parse distance %q: %w", distance.String(), err)
+	}
+
+	return Race{
+		Time:     t,
+		Distance: d,
+	}, nil
+}
+
+// Interval of hold times [Min, Max]. It's empty if Min > Max.
+type Interval struct {
+	Min int
+	Max int
+}
+
+func (i Interval) Count() int {
+	return max(i.Max-i.Min+1, 0)
+}
+
+// Winning returns hold times t that beat the record, i.e. t * (race.Time - t) > race.Distance. They are between roots
+// of the quadratic, found exactly with the integer square root of its discriminant.
+func (r Race) Winning() Interval {
+	if !r.beats(r.Time / 2) {
+		return Interval{Min: 1, Max: 0}
+	}
+
+	// The discriminant overflows int for kerned races.
+	d := new(big.Int).Mul(big.NewInt(int64(r.Time)), big.NewInt(int64(r.Time)))
+	d.Sub(d, new(big.Int).Mul(big.NewInt(4), big.NewInt(int64(r.Distance))))
+
+	// The smaller root is (time - sqrt(d)) / 2, it's off by one at most when rounded.
+	t := (r.Time - int(d.Sqrt(d).Int64())) / 2
+	for !r.beats(t) {
+		t++
+	}
+
+	for t > 0 && r.beats(t-1) {
+		t--
+	}
+
+	return Interval{Min: t, Max: r.Time - t}
+}
+
+// beats reports whether holding the button for t beats the record, in 128 bits not to overflow.
+func (r Race) beats(t int) bool {
+	hi, lo := bits.Mul64(uint64(t), uint64(r.Time-t))
+	return hi > 0 || lo > uint64(r.Distance)
+}
@@ -0,0 +1,87 @@
+package waitforit
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/harmlessevil/advent-of-code-2023/puzzles/internal/puzzletest"
+)
+
+func TestExamples(t *testing.T) {
+	puzzletest.Run(t, []puzzletest.Case{
+		{
+			Name:  "part 1",
+			Solve: Part1,
+			Input: "testdata/example.txt",
+			Want:  288,
+		},
+		{
+			Name:  "part 2",
+			Solve: Part2,
+			Input: "testdata/example.txt",
+			Want:  71503,
+		},
+	})
+}
+
+func BenchmarkParse(b *testing.B) {
+	puzzletest.Benchmark(b, 6, "testdata/example.txt", ParseRaces)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	puzzletest.Benchmark(b, 6, "testdata/example.txt", Part1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	puzzletest.Benchmark(b, 6, "testdata/example.txt", Part2)
+}
+
+// TestWinningAgainstBruteForce compares the exact solver with trying every hold time.
+func TestWinningAgainstBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 10000; i++ {
+		race := Race{Time: rng.Intn(200)}
+		race.Distance = rng.Intn(race.Time*race.Time/4 + 5)
+
+		want := Interval{Min: 1, Max: 0}
+		for hold := 0; hold <= race.Time; hold++ {
+			if hold*(race.Time-hold) > race.Distance {
+				if want.Count() == 0 {
+					want.Min = hold
+				}
+
+				want.Max = hold
+			}
+		}
+
+		if got := race.Winning(); got.Count() != want.Count() || want.Count() > 0 && got != want {
+			t.Fatalf("%+v: got %+v, want %+v", race, got, want)
+		}
+	}
+}
+
+func TestWinningExactly(t *testing.T) {
+	cases := []struct {
+		race Race
+		want Interval
+	}{
+		// Holding for 3 or 7 only matches the record of 21.
+		{race: Race{Time: 10, Distance: 21}, want: Interval{Min: 4, Max: 6}},
+		{race: Race{Time: 30, Distance: 200}, want: Interval{Min: 11, Max: 19}},
+		// Only the middle beats the record by one, too close for float64.
+		{race: Race{Time: 2_000_000_000, Distance: 999_999_999_999_999_999}, want: Interval{Min: 1e9, Max: 1e9}},
+		// Products overflow int.
+		{race: Race{Time: 10_000_000_000, Distance: 1}, want: Interval{Min: 1, Max: 9_999_999_999}},
+	}
+
+	for _, c := range cases {
+		if got := c.race.Winning(); got != c.want {
+			t.Errorf("%+v: got %+v, want %+v", c.race, got, c.want)
+		}
+	}
+
+	if got := (Race{Time: 10, Distance: 25}).Winning(); got.Count() != 0 {
+		t.Errorf("got %+v, want no way to beat a record of the longest distance", got)
+	}
+}